@@ -0,0 +1,126 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// combustionMassTolerance is the fraction of the sample mass that the collected products may
+// over- or under-account for before oxygen by difference is treated as real.
+var combustionMassTolerance = decimal.NewFromFloat(0.01)
+
+// CombustionData is what a combustion train collects from burning a CxHyOz(Nw) sample.
+// Nitrogen is optional and may be collected as either N2 or NO2, but not both.
+type CombustionData struct {
+	Sample Mass
+	CO2    Mass
+	H2O    Mass
+	N2     Mass
+	NO2    Mass
+}
+
+// CombustionStep traces one element of the sample back to the product it was measured from.
+type CombustionStep struct {
+	Element      string
+	From         string          // the product collected, or "difference" for oxygen
+	ProductMoles decimal.Decimal // moles of the collected product, zero for oxygen
+	Moles        decimal.Decimal // moles of the element in the sample
+	Mass         decimal.Decimal // grams of the element in the sample
+}
+
+// CombustionResult shows each step of a combustion analysis and the empirical formula it leads to.
+type CombustionResult struct {
+	SampleMass decimal.Decimal // grams
+	Steps      []CombustionStep
+	Empirical  Compound
+}
+
+// CombustionAnalysis finds the moles of carbon, hydrogen and nitrogen from the products collected,
+// infers oxygen by difference from the sample mass, and reduces them to an empirical formula.
+func CombustionAnalysis(data CombustionData, pt *PeriodicTable) (CombustionResult, error) {
+	sampleMass, err := data.Sample.convertToStandard()
+	if err != nil {
+		return CombustionResult{}, fmt.Errorf("sample mass: %v", err)
+	}
+	if !data.N2.value.IsZero() && !data.NO2.value.IsZero() {
+		return CombustionResult{}, fmt.Errorf("nitrogen must be collected as N2 or NO2, not both")
+	}
+	products := []struct {
+		formula string
+		element string
+		atoms   int64
+		mass    Mass
+	}{
+		{"CO2", "C", 1, data.CO2},
+		{"H2O", "H", 2, data.H2O},
+		{"N2", "N", 2, data.N2},
+		{"NO2", "N", 1, data.NO2},
+	}
+
+	result := CombustionResult{SampleMass: sampleMass}
+	accounted := decimal.Zero
+	for _, product := range products {
+		if product.mass.value.IsZero() {
+			if product.formula == "CO2" || product.formula == "H2O" {
+				return CombustionResult{}, fmt.Errorf("mass of %s collected is required", product.formula)
+			}
+			continue
+		}
+		compound, err := NewCompound(product.formula, pt)
+		if err != nil {
+			return CombustionResult{}, err
+		}
+		if err := compound.getMolesFromMass(product.mass); err != nil {
+			return CombustionResult{}, fmt.Errorf("%s: %v", product.formula, err)
+		}
+		step, err := combustionStep(product.element, product.formula, compound.Moles, compound.Moles.Mul(decimal.NewFromInt(product.atoms)), pt)
+		if err != nil {
+			return CombustionResult{}, err
+		}
+		accounted = accounted.Add(step.Mass)
+		result.Steps = append(result.Steps, step)
+	}
+
+	oxygen := sampleMass.Sub(accounted)
+	allowance := sampleMass.Mul(combustionMassTolerance)
+	switch {
+	case oxygen.LessThan(allowance.Neg()):
+		return CombustionResult{}, fmt.Errorf("products account for %v g, more than the %v g sample", accounted.StringFixed(4), sampleMass)
+	case oxygen.GreaterThan(allowance):
+		element, found := pt.FindElementBySymbol("O")
+		if !found {
+			return CombustionResult{}, fmt.Errorf("element O not found in the periodic table")
+		}
+		result.Steps = append(result.Steps, CombustionStep{
+			Element: "O",
+			From:    "difference",
+			Moles:   oxygen.Div(element.AtomicWeight),
+			Mass:    oxygen,
+		})
+	}
+
+	composition := make(map[string]decimal.Decimal)
+	for _, step := range result.Steps {
+		composition[step.Element] = step.Mass
+	}
+	result.Empirical, err = EmpiricalFormula(composition, pt, decimal.Zero)
+	if err != nil {
+		return CombustionResult{}, err
+	}
+	return result, nil
+}
+
+func combustionStep(symbol, from string, productMoles, moles decimal.Decimal, pt *PeriodicTable) (CombustionStep, error) {
+	element, found := pt.FindElementBySymbol(symbol)
+	if !found {
+		return CombustionStep{}, fmt.Errorf("element %s not found in the periodic table", symbol)
+	}
+	return CombustionStep{
+		Element:      symbol,
+		From:         from,
+		ProductMoles: productMoles,
+		Moles:        moles,
+		Mass:         moles.Mul(element.AtomicWeight),
+	}, nil
+}
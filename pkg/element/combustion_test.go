@@ -0,0 +1,88 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCombustionAnalysis(t *testing.T) {
+	pt := NewPeriodicTable()
+	grams := func(value string) Mass {
+		return Mass{value: decimal.RequireFromString(value), unit: Gram, prefix: NoPrefix}
+	}
+	tests := []struct {
+		name          string
+		data          CombustionData
+		expected      string
+		expectedSteps []string
+		expectedError bool
+	}{
+		{
+			name:          "propanol with oxygen by difference",
+			data:          CombustionData{Sample: grams("0.255"), CO2: grams("0.561"), H2O: grams("0.306")},
+			expected:      "C3H8O",
+			expectedSteps: []string{"C", "H", "O"},
+		},
+		{
+			name:          "nicotine collected as N2",
+			data:          CombustionData{Sample: grams("0.5000"), CO2: grams("1.3564"), H2O: grams("0.38868"), N2: grams("0.08634")},
+			expected:      "C5H7N",
+			expectedSteps: []string{"C", "H", "N"},
+		},
+		{
+			name:          "sample in milligrams",
+			data:          CombustionData{Sample: Mass{value: decimal.RequireFromString("255"), unit: Gram, prefix: Milli}, CO2: grams("0.561"), H2O: grams("0.306")},
+			expected:      "C3H8O",
+			expectedSteps: []string{"C", "H", "O"},
+		},
+		{name: "missing CO2", data: CombustionData{Sample: grams("0.255"), H2O: grams("0.306")}, expectedError: true},
+		{name: "missing sample", data: CombustionData{CO2: grams("0.561"), H2O: grams("0.306")}, expectedError: true},
+		{name: "both N2 and NO2", data: CombustionData{Sample: grams("0.5"), CO2: grams("1.3564"), H2O: grams("0.38868"), N2: grams("0.08"), NO2: grams("0.1")}, expectedError: true},
+		{name: "products outweigh sample", data: CombustionData{Sample: grams("0.100"), CO2: grams("0.561"), H2O: grams("0.306")}, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := CombustionAnalysis(test.data, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if result.Empirical.Symbol != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, result.Empirical.Symbol)
+			}
+			if len(result.Steps) != len(test.expectedSteps) {
+				t.Fatalf("Expected %d steps, but got %d", len(test.expectedSteps), len(result.Steps))
+			}
+			for i, step := range result.Steps {
+				if step.Element != test.expectedSteps[i] {
+					t.Errorf("Expected step %d to be %s, but got %s", i, test.expectedSteps[i], step.Element)
+				}
+			}
+		})
+	}
+}
+
+func TestCombustionStepsShowTheirWork(t *testing.T) {
+	pt := NewPeriodicTable()
+	data := CombustionData{
+		Sample: Mass{value: decimal.RequireFromString("0.255"), unit: Gram, prefix: NoPrefix},
+		CO2:    Mass{value: decimal.RequireFromString("0.561"), unit: Gram, prefix: NoPrefix},
+		H2O:    Mass{value: decimal.RequireFromString("0.306"), unit: Gram, prefix: NoPrefix},
+	}
+	result, err := CombustionAnalysis(data, pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hydrogen := result.Steps[1]
+	if hydrogen.From != "H2O" || !hydrogen.Moles.Equal(hydrogen.ProductMoles.Mul(decimal.NewFromInt(2))) {
+		t.Errorf("Expected two moles of H per mole of H2O, but got %v from %v", hydrogen.Moles, hydrogen.ProductMoles)
+	}
+	oxygen := result.Steps[2]
+	total := result.Steps[0].Mass.Add(hydrogen.Mass).Add(oxygen.Mass)
+	if oxygen.From != "difference" || !total.Equal(result.SampleMass) {
+		t.Errorf("Expected element masses to sum to %v, but got %v", result.SampleMass, total)
+	}
+}
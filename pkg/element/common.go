@@ -5,7 +5,7 @@ import (
 )
 
 // Shared testing objects
-var preciseHOHMoles, _ =decimal.NewFromString("1258.9286705523175132") // floats are not precise enough
+var preciseHOHMoles, _ =decimal.NewFromString("1258.9296974743269498") // floats are not precise enough
 var TestCompounds = []struct {
 	compound     Compound
 	expectedError bool
@@ -21,10 +21,10 @@ var TestCompounds = []struct {
 				{Element: Element{Symbol: "O", Name: "Oxygen", AtomicNumber: 8, AtomicWeight: decimal.NewFromFloat(15.999)}, Moles: decimal.NewFromFloat(1)},
 			},
 			Mass: Mass{value: decimal.NewFromFloat(18.015)},
-			Volume: Volume{value: decimal.NewFromInt(1), unit: none },
+			Volume: Volume{value: decimal.NewFromInt(1), unit: Liter, prefix: NoPrefix},
 			},
 			molarity: decimal.NewFromInt(1),
-			massForMoles: Mass{value: decimal.NewFromFloat(18.015), unit: gram, prefix: none},
+			massForMoles: Mass{value: decimal.NewFromFloat(18.015), unit: Gram, prefix: NoPrefix},
 			expectedMoles: decimal.NewFromInt(1),
 			expectedError: false,
 
@@ -37,9 +37,9 @@ var TestCompounds = []struct {
 				{Element: Element{Symbol: "Cl", Name: "Chlorine", AtomicNumber: 17, AtomicWeight: decimal.NewFromFloat(35.45)}, Moles: decimal.NewFromFloat(1)},
 			},
 			Mass: Mass{value: decimal.NewFromFloat(58.44)},
-			Volume: Volume{value: decimal.NewFromInt(500), unit: none}},
+			Volume: Volume{value: decimal.NewFromInt(500), unit: Liter, prefix: NoPrefix}},
 			molarity: decimal.NewFromInt(2),
-			massForMoles: Mass{value: decimal.NewFromFloat(58.44), prefix: kilo, unit: gram},
+			massForMoles: Mass{value: decimal.NewFromFloat(58.44), prefix: Kilo, unit: Gram},
 			expectedMoles: decimal.NewFromFloat(1000),
 			expectedError: false,
 	},
@@ -50,9 +50,9 @@ var TestCompounds = []struct {
 			{Element: Element{Symbol: "O", Name: "Oxygen", AtomicNumber: 8, AtomicWeight: decimal.NewFromFloat(15.999)}, Moles: decimal.NewFromFloat(1)},
 		},
 		Mass: Mass{value: decimal.NewFromFloat(18.015)},
-		Volume: Volume{value: decimal.NewFromFloat(1), unit: micro},},
+		Volume: Volume{value: decimal.NewFromFloat(1), unit: Liter, prefix: Micro},},
 		molarity: preciseHOHMoles.Mul(decimal.NewFromInt(1000000)),
-		massForMoles: Mass{value: decimal.NewFromFloat(50), prefix: none, unit: pound},
+		massForMoles: Mass{value: decimal.NewFromFloat(50), prefix: NoPrefix, unit: Pound},
 		expectedMoles: preciseHOHMoles,
 		expectedError: false,
 	},
@@ -65,9 +65,9 @@ var TestCompounds = []struct {
 				{Element: Element{Symbol: "O", Name: "Oxygen", AtomicNumber: 8, AtomicWeight: decimal.NewFromFloat(15.999)}, Moles: decimal.NewFromFloat(6)},
 			},
 		Mass: Mass{value: decimal.NewFromFloat(180.156)},
-		Volume: Volume{value: decimal.NewFromFloat(2.50), unit: none}},
+		Volume: Volume{value: decimal.NewFromFloat(2.50), unit: Liter, prefix: NoPrefix}},
 		molarity: decimal.NewFromFloat(0.4),
-		massForMoles: Mass{value: decimal.NewFromFloat(18.0156),prefix: deca, unit: gram},
+		massForMoles: Mass{value: decimal.NewFromFloat(18.0156),prefix: Deca, unit: Gram},
 		expectedMoles: decimal.NewFromFloat(1),
 		expectedError: false,
 	},
@@ -75,21 +75,21 @@ var TestCompounds = []struct {
 		compound: Compound{
 			Symbol: "XYZ",
 			Elements: nil},
-		massForMoles: Mass{value: decimal.NewFromFloat(5),prefix: kilo, unit: gram},
+		massForMoles: Mass{value: decimal.NewFromFloat(5),prefix: Kilo, unit: Gram},
 		expectedMoles: decimal.Zero,
 		expectedError: true,
 	},
 	{
 		compound: Compound{Symbol: "H2O1X",
 		Elements: nil},
-		massForMoles: Mass{value: decimal.NewFromFloat(15), prefix: kilo, unit: gram},
+		massForMoles: Mass{value: decimal.NewFromFloat(15), prefix: Kilo, unit: Gram},
 		expectedMoles: decimal.Zero,
 		expectedError: true,
 	},
 	{
 		compound: Compound{Symbol: "",
 		Elements: nil},
-		massForMoles: Mass{value: decimal.NewFromFloat(1), prefix: kilo, unit: gram},
+		massForMoles: Mass{value: decimal.NewFromFloat(1), prefix: Kilo, unit: Gram},
 		expectedMoles: decimal.Zero,
 		expectedError: true,
 	},
@@ -101,9 +101,9 @@ var TestCompounds = []struct {
 				{Element: Element{Symbol: "O", Name: "Oxygen", AtomicNumber: 8, AtomicWeight: decimal.NewFromFloat(15.999)}, Moles: decimal.NewFromFloat(1)},
 			},
 			Mass: Mass{value: decimal.NewFromFloat(18.015)},
-			Volume: Volume{value: decimal.NewFromInt(1), unit: micro}},
+			Volume: Volume{value: decimal.NewFromInt(1), unit: Liter, prefix: Micro}},
 			molarity: decimal.NewFromInt(1),
-			massForMoles: Mass{value: decimal.NewFromFloat(18.015), unit: gram, prefix: micro},
+			massForMoles: Mass{value: decimal.NewFromFloat(18.015), unit: Gram, prefix: Micro},
 			expectedMoles: decimal.NewFromFloat(.000001),
 			expectedError: false,
 
@@ -0,0 +1,72 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ElementFraction is one element's share of a compound's mass.
+type ElementFraction struct {
+	Element  Element
+	Fraction decimal.Decimal // mass fraction, between 0 and 1
+	Percent  decimal.Decimal // mass percent, between 0 and 100
+}
+
+// PercentComposition returns each element's mass fraction using the same atomic weights as the molar mass.
+// Isotope-labelled atoms are counted with the rest of their element.
+func (c Compound) PercentComposition() ([]ElementFraction, error) {
+	masses, err := c.elementMasses()
+	if err != nil {
+		return nil, err
+	}
+	fractions := make([]ElementFraction, len(masses))
+	for i, em := range masses {
+		fraction := em.mass.Div(c.MolarMass)
+		fractions[i] = ElementFraction{Element: em.element, Fraction: fraction, Percent: fraction.Mul(decimal.NewFromInt(100))}
+	}
+	return fractions, nil
+}
+
+// ElementMass is the mass of one element contained in a mass of the compound, in the same unit and prefix.
+func (c Compound) ElementMass(symbol string, m Mass) (Mass, error) {
+	masses, err := c.elementMasses()
+	if err != nil {
+		return Mass{}, err
+	}
+	for _, em := range masses {
+		if em.element.Symbol == symbol {
+			// Multiplying before dividing keeps exact inputs exact.
+			return Mass{value: m.value.Mul(em.mass).Div(c.MolarMass), unit: m.unit, prefix: m.prefix}, nil
+		}
+	}
+	return Mass{}, fmt.Errorf("%s does not contain %s", c.Symbol, symbol)
+}
+
+// elementMass is the grams of one element in a mole of compound.
+type elementMass struct {
+	element Element
+	mass    decimal.Decimal
+}
+
+// elementMasses totals the grams of each element per mole of compound and fills in c.MolarMass.
+func (c *Compound) elementMasses() ([]elementMass, error) {
+	if err := c.getMolarMass(); err != nil {
+		return nil, err
+	}
+	if c.MolarMass.IsZero() {
+		return nil, fmt.Errorf("compound %s has no molar mass", c.Symbol)
+	}
+	var masses []elementMass
+	index := make(map[string]int)
+	for _, em := range c.Elements {
+		mass := em.atomicMass().Mul(em.Moles)
+		if i, seen := index[em.Element.Symbol]; seen {
+			masses[i].mass = masses[i].mass.Add(mass)
+			continue
+		}
+		index[em.Element.Symbol] = len(masses)
+		masses = append(masses, elementMass{element: em.Element, mass: mass})
+	}
+	return masses, nil
+}
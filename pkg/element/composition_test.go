@@ -0,0 +1,64 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPercentComposition(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula  string
+		expected map[string]string // symbol to percent, rounded to 2 places
+	}{
+		{formula: "H2O", expected: map[string]string{"H": "11.19", "O": "88.81"}},
+		{formula: "C6H12O6", expected: map[string]string{"C": "40", "H": "6.71", "O": "53.28"}},
+		{formula: "NaCl", expected: map[string]string{"Na": "39.34", "Cl": "60.66"}},
+		{formula: "[13C]H3CH3", expected: map[string]string{"C": "80.53", "H": "19.47"}},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			compound, err := NewCompound(test.formula, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			fractions, err := compound.PercentComposition()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(fractions) != len(test.expected) {
+				t.Errorf("Expected %d elements, but got %d", len(test.expected), len(fractions))
+			}
+			total := decimal.Zero
+			for _, fraction := range fractions {
+				expected := decimal.RequireFromString(test.expected[fraction.Element.Symbol])
+				if !fraction.Percent.Round(2).Equal(expected) {
+					t.Errorf("Expected %v%% %s, but got %v", expected, fraction.Element.Symbol, fraction.Percent)
+				}
+				total = total.Add(fraction.Fraction)
+			}
+			if !total.Round(10).Equal(decimal.NewFromInt(1)) {
+				t.Errorf("Expected fractions to sum to 1, but got %v", total)
+			}
+		})
+	}
+}
+
+func TestElementMass(t *testing.T) {
+	pt := NewPeriodicTable()
+	water, _ := NewCompound("H2O", pt)
+	oxygen, err := water.ElementMass("O", Mass{value: decimal.RequireFromString("36.03"), unit: Gram, prefix: Kilo})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !oxygen.Value().Equal(decimal.RequireFromString("31.998")) || oxygen.prefix != Kilo {
+		t.Errorf("Expected 31.998 kg of oxygen, but got %v with prefix %v", oxygen.Value(), oxygen.prefix)
+	}
+	if _, err := water.ElementMass("C", Mass{value: decimal.NewFromInt(1), unit: Gram, prefix: NoPrefix}); err == nil {
+		t.Errorf("Expected an error for an element not in the compound")
+	}
+	if _, err := (Compound{Symbol: "XYZ"}).PercentComposition(); err == nil {
+		t.Errorf("Expected an error for a compound without elements")
+	}
+}
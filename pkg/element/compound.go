@@ -1,10 +1,7 @@
 package element
 
 import (
-	"fmt"
-	"regexp"
 	"sort"
-	"strconv"
 
 	"github.com/shopspring/decimal"
 )
@@ -12,6 +9,15 @@ import (
 type ElementMoles struct { // when creating compounds. I could just have moles be part of the element struct, but this is less confusing when balancing equations.
 	Element Element
 	Moles decimal.Decimal
+	Isotope *Isotope // set for isotope-labelled atoms such as [13C], nil for natural abundance
+}
+
+// atomicMass is the labelled isotope's exact mass, or the standard atomic weight.
+func (em ElementMoles) atomicMass() decimal.Decimal {
+	if em.Isotope != nil {
+		return em.Isotope.ExactMass
+	}
+	return em.Element.AtomicWeight
 }
 
 type Compound struct {
@@ -29,37 +35,35 @@ func sortElementMoles(elements []ElementMoles) {
 	})
 }
 
+// ParseCompoundElements parses a formula such as "Ca(OH)2" or "K4[Fe(CN)6]" into element counts.
+// Groups may use (), [] or {} and nest to any depth, and hydrates such as "CuSO4·5H2O" are totalled.
 func ParseCompoundElements(compound string, pt *PeriodicTable) ([]ElementMoles, error) {
-	if compound =="" {
-		return nil, fmt.Errorf("no compound symbols passed")
+	segments, err := parseSegments(compound, pt)
+	if err != nil {
+		return nil, err
 	}
-	var elements []ElementMoles
-	re := regexp.MustCompile(`([A-Z][a-z]?)(\d*)`)
-	elementMolesMap := make(map[string]int64)
-	matches := re.FindAllStringSubmatch(compound, -1)
-
-	for _, match := range matches {
-		elementSymbol := match[1]
-		count := match[2]
+	total := newAtomCounts()
+	for _, segment := range segments {
+		total.merge(segment.counts, segment.coefficient) // parseSegments checked the totals fit
+	}
+	return total.toElementMoles(pt), nil
+}
 
-		if count == "" {
-			count = "1"
-		}
-		moles, err := strconv.ParseInt(count, 10,0)
-		if err != nil {
-			return nil, err
-		}
-		_, found := pt.FindElementBySymbol(elementSymbol)
-		if !found {
-			return nil, fmt.Errorf("element %s not found in the periodic table", elementSymbol)
-		}
+// parseSegments returns a *FormulaError pointing at the first character it could not use.
+func parseSegments(compound string, pt *PeriodicTable) ([]formulaSegment, error) {
+	parser := formulaParser{input: compound, pt: pt}
+	return parser.parseCompound()
+}
 
-		elementMolesMap[elementSymbol] += moles
+// NewCompound parses the formula and fills in its elements and molar mass.
+func NewCompound(symbol string, pt *PeriodicTable) (Compound, error) {
+	elements, err := ParseCompoundElements(symbol, pt)
+	if err != nil {
+		return Compound{}, err
 	}
-	for symbol, moles := range elementMolesMap {
-		element, _ := pt.FindElementBySymbol(symbol) // We know the element exists, so this is safe
-		elements = append(elements, ElementMoles{Element: *element, Moles: decimal.NewFromInt(moles)})
+	compound := Compound{Symbol: symbol, Elements: elements}
+	if err := compound.getMolarMass(); err != nil {
+		return Compound{}, err
 	}
-	return elements, nil
+	return compound, nil
 }
-
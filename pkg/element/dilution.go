@@ -0,0 +1,233 @@
+package element
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// The dilution functions solve C1V1 = C2V2, where 1 is the stock solution and 2 the diluted one.
+// Concentrations can be in any unit as long as both are in the same one.
+
+func checkDilution(stock, diluted decimal.Decimal) error {
+	if !stock.IsPositive() || !diluted.IsPositive() {
+		return fmt.Errorf("concentrations must be positive, got %v and %v", stock, diluted)
+	}
+	if diluted.GreaterThan(stock) {
+		return fmt.Errorf("diluting a %v solution cannot make it %v", stock, diluted)
+	}
+	return nil
+}
+
+func dilutionVolumes(v1, v2 Volume) (decimal.Decimal, decimal.Decimal, error) {
+	liters1, err := v1.convertToStandard()
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	liters2, err := v2.convertToStandard()
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	if liters2.LessThan(liters1) {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("the diluted volume cannot be smaller than the volume of stock taken")
+	}
+	return liters1, liters2, nil
+}
+
+// DilutedConcentration solves C2 = C1V1/V2.
+func DilutedConcentration(stock decimal.Decimal, v1, v2 Volume) (decimal.Decimal, error) {
+	if !stock.IsPositive() {
+		return decimal.Zero, fmt.Errorf("concentration must be positive, got %v", stock)
+	}
+	liters1, liters2, err := dilutionVolumes(v1, v2)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quotient(stock.Mul(liters1), liters2), nil
+}
+
+// StockConcentration solves C1 = C2V2/V1.
+func StockConcentration(v1 Volume, diluted decimal.Decimal, v2 Volume) (decimal.Decimal, error) {
+	if !diluted.IsPositive() {
+		return decimal.Zero, fmt.Errorf("concentration must be positive, got %v", diluted)
+	}
+	liters1, liters2, err := dilutionVolumes(v1, v2)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quotient(diluted.Mul(liters2), liters1), nil
+}
+
+// StockVolume solves V1 = C2V2/C1, the volume of stock to dilute, in the same unit as V2.
+func StockVolume(stock, diluted decimal.Decimal, v2 Volume) (Volume, error) {
+	if err := checkDilution(stock, diluted); err != nil {
+		return Volume{}, err
+	}
+	if !v2.value.IsPositive() {
+		return Volume{}, fmt.Errorf("volume must be positive, got %v", v2.value)
+	}
+	return Volume{value: quotient(diluted.Mul(v2.value), stock), unit: v2.unit, prefix: v2.prefix}, nil
+}
+
+// DilutedVolume solves V2 = C1V1/C2, the volume to make the stock up to, in the same unit as V1.
+func DilutedVolume(stock decimal.Decimal, v1 Volume, diluted decimal.Decimal) (Volume, error) {
+	if err := checkDilution(stock, diluted); err != nil {
+		return Volume{}, err
+	}
+	if !v1.value.IsPositive() {
+		return Volume{}, fmt.Errorf("volume must be positive, got %v", v1.value)
+	}
+	return Volume{value: quotient(stock.Mul(v1.value), diluted), unit: v1.unit, prefix: v1.prefix}, nil
+}
+
+// DilutionStep is one step of a serial dilution: pipette the Aliquot of the previous
+// solution into a flask and add Diluent up to the Final volume, giving Concentration.
+type DilutionStep struct {
+	Aliquot       Volume
+	Diluent       Volume
+	Final         Volume
+	Concentration decimal.Decimal
+}
+
+// maxDilutionSteps bounds the search for a serial dilution plan.
+const maxDilutionSteps = 6
+
+// DefaultDilutionTolerance is how far, as a fraction of the target, a serial dilution plan may
+// leave the final concentration when the glassware cannot reach the target exactly.
+var DefaultDilutionTolerance = decimal.NewFromFloat(0.01)
+
+// dilutionFactor is one way to use the glassware, taking an aliquot and making it up to a final volume.
+type dilutionFactor struct {
+	aliquot, final Volume
+	factor         *big.Rat
+}
+
+// PlanSerialDilution plans the fewest dilutions that take a stock solution down to a
+// target concentration using only the glassware volumes available, each of which may be
+// used as a pipette for the aliquot or a flask to make it up in. Each step dilutes by the
+// ratio of two glassware volumes, so the overall dilution is a product of those ratios and
+// many targets cannot be hit exactly. The plan's final concentration must land within
+// tolerance of the target, as a fraction of it; a zero tolerance uses DefaultDilutionTolerance.
+// Among plans with the fewest steps the closest is used, and among equally close plans,
+// large dilutions early and large aliquots are preferred. The last step's Concentration is
+// what the plan actually makes.
+func PlanSerialDilution(stock, target decimal.Decimal, glassware []Volume, tolerance decimal.Decimal) ([]DilutionStep, error) {
+	if err := checkDilution(stock, target); err != nil {
+		return nil, err
+	}
+	if stock.Equal(target) {
+		return nil, fmt.Errorf("the stock is already at %v", target)
+	}
+	if tolerance.IsZero() {
+		tolerance = DefaultDilutionTolerance
+	}
+	if tolerance.IsNegative() {
+		return nil, fmt.Errorf("tolerance must be positive, got %v", tolerance)
+	}
+	if tolerance.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return nil, fmt.Errorf("tolerance must be below 1, got %v", tolerance)
+	}
+	factors, err := dilutionFactors(glassware)
+	if err != nil {
+		return nil, err
+	}
+	goal := new(big.Rat).Quo(stock.Rat(), target.Rat())
+	allowed := tolerance.Rat()
+	// An overall dilution D makes stock/D, which is within tolerance below the target only
+	// while D ≤ goal/(1-tolerance), and further steps only dilute more.
+	limit := new(big.Rat).Quo(goal, new(big.Rat).Sub(big.NewRat(1, 1), allowed))
+
+	// Search breadth first by number of steps, keeping the first way to reach each overall dilution.
+	type node struct {
+		total *big.Rat
+		steps []dilutionFactor
+	}
+	level := []node{{total: big.NewRat(1, 1)}}
+	seen := map[string]bool{level[0].total.RatString(): true}
+	for depth := 0; depth < maxDilutionSteps && len(level) > 0; depth++ {
+		var next []node
+		var best []dilutionFactor
+		var bestMiss *big.Rat
+		for _, n := range level {
+			for _, f := range factors {
+				total := new(big.Rat).Mul(n.total, f.factor)
+				if total.Cmp(limit) > 0 || seen[total.RatString()] {
+					continue
+				}
+				seen[total.RatString()] = true
+				steps := append(append([]dilutionFactor{}, n.steps...), f)
+				// The concentration misses the target by |goal/total - 1| of it.
+				miss := new(big.Rat).Sub(new(big.Rat).Quo(goal, total), big.NewRat(1, 1))
+				miss.Abs(miss)
+				if miss.Cmp(allowed) <= 0 && (bestMiss == nil || miss.Cmp(bestMiss) < 0) {
+					best, bestMiss = steps, miss
+				}
+				next = append(next, node{total: total, steps: steps})
+			}
+		}
+		if best != nil {
+			return dilutionPlan(stock, best)
+		}
+		level = next
+	}
+	return nil, fmt.Errorf("no plan of up to %d steps dilutes %v to within %v%% of %v, since each step can only dilute by the ratio of two of the glassware volumes available",
+		maxDilutionSteps, stock, tolerance.Mul(decimal.NewFromInt(100)), target)
+}
+
+// dilutionFactors lists each aliquot and final volume pair, largest dilution first and then largest aliquot first.
+func dilutionFactors(glassware []Volume) ([]dilutionFactor, error) {
+	liters := make([]*big.Rat, len(glassware))
+	for i, v := range glassware {
+		l, err := v.convertToStandard()
+		if err != nil {
+			return nil, err
+		}
+		if !l.IsPositive() {
+			return nil, fmt.Errorf("glassware volumes must be positive, got %v", v.value)
+		}
+		liters[i] = l.Rat()
+	}
+	var factors []dilutionFactor
+	for i, aliquot := range glassware {
+		for j, final := range glassware {
+			if liters[i].Cmp(liters[j]) >= 0 {
+				continue
+			}
+			factors = append(factors, dilutionFactor{aliquot: aliquot, final: final, factor: new(big.Rat).Quo(liters[j], liters[i])})
+		}
+	}
+	if len(factors) == 0 {
+		return nil, fmt.Errorf("a dilution needs at least two different glassware volumes")
+	}
+	sort.SliceStable(factors, func(i, j int) bool {
+		if c := factors[i].factor.Cmp(factors[j].factor); c != 0 {
+			return c > 0
+		}
+		a, _ := factors[i].aliquot.convertToStandard()
+		b, _ := factors[j].aliquot.convertToStandard()
+		return a.GreaterThan(b)
+	})
+	return factors, nil
+}
+
+func dilutionPlan(stock decimal.Decimal, factors []dilutionFactor) ([]DilutionStep, error) {
+	plan := make([]DilutionStep, len(factors))
+	total := big.NewRat(1, 1)
+	for i, f := range factors {
+		aliquot, err := f.aliquot.Convert(f.final.unit, f.final.prefix)
+		if err != nil {
+			return nil, err
+		}
+		total.Mul(total, f.factor)
+		concentration := ratDecimal(new(big.Rat).Quo(stock.Rat(), total))
+		plan[i] = DilutionStep{
+			Aliquot:       f.aliquot,
+			Diluent:       Volume{value: f.final.value.Sub(aliquot.value), unit: f.final.unit, prefix: f.final.prefix},
+			Final:         f.final,
+			Concentration: concentration,
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,118 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDilution(t *testing.T) {
+	stock, diluted := decimal.NewFromInt(12), decimal.NewFromInt(1)
+	aliquot, _ := NewVolume(decimal.NewFromInt(25), Milli)
+	flask, _ := NewVolume(decimal.NewFromInt(300), Milli)
+	liter, _ := NewVolume(decimal.RequireFromString("0.3"))
+
+	if c, err := DilutedConcentration(stock, aliquot, liter); err != nil || !c.Equal(diluted) {
+		t.Errorf("Expected 1 M, but got %v (%v)", c, err)
+	}
+	if c, err := StockConcentration(aliquot, diluted, flask); err != nil || !c.Equal(stock) {
+		t.Errorf("Expected 12 M, but got %v (%v)", c, err)
+	}
+	if v, err := StockVolume(stock, diluted, flask); err != nil || !v.Value().Equal(decimal.NewFromInt(25)) || v.prefix != Milli {
+		t.Errorf("Expected 25 mL, but got %v (%v)", v, err)
+	}
+	if v, err := DilutedVolume(stock, aliquot, diluted); err != nil || !v.Value().Equal(decimal.NewFromInt(300)) || v.prefix != Milli {
+		t.Errorf("Expected 300 mL, but got %v (%v)", v, err)
+	}
+
+	if _, err := DilutedConcentration(stock, flask, aliquot); err == nil {
+		t.Errorf("Expected an error for a final volume smaller than the aliquot")
+	}
+	if _, err := StockVolume(diluted, stock, flask); err == nil {
+		t.Errorf("Expected an error for a diluted concentration above the stock")
+	}
+	if _, err := DilutedVolume(stock, Volume{}, diluted); err == nil {
+		t.Errorf("Expected an error for an empty volume")
+	}
+}
+
+func TestPlanSerialDilution(t *testing.T) {
+	milliliters := func(values ...int64) []Volume {
+		var glassware []Volume
+		for _, value := range values {
+			v, _ := NewVolume(decimal.NewFromInt(value), Milli)
+			glassware = append(glassware, v)
+		}
+		return glassware
+	}
+	quarterLiter, _ := NewVolume(decimal.RequireFromString("0.25"))
+	oddFlask, _ := NewVolume(decimal.RequireFromString("101.01"), Milli)
+	type step struct {
+		aliquot, diluent, final, concentration string
+	}
+	tests := []struct {
+		name          string
+		stock         string
+		target        string
+		glassware     []Volume
+		tolerance     string
+		expected      []step
+		expectedError bool
+	}{
+		{
+			name: "thousandfold in two steps", stock: "1", target: "0.001", glassware: milliliters(1, 10, 100),
+			expected: []step{{"1", "99", "100", "0.01"}, {"10", "90", "100", "0.001"}},
+		},
+		{
+			name: "single step into a liter flask", stock: "2", target: "0.04", glassware: append(milliliters(5, 25), quarterLiter),
+			expected: []step{{"5", "0.245", "0.25", "0.04"}},
+		},
+		{
+			name: "glassware order does not matter", stock: "0.5", target: "0.002", glassware: milliliters(50, 2, 10, 100),
+			expected: []step{{"2", "98", "100", "0.01"}, {"10", "40", "50", "0.002"}},
+		},
+		{
+			name: "closest plan within tolerance", stock: "1", target: "0.0123", glassware: milliliters(1, 2, 5, 10, 25, 50, 100), tolerance: "0.02",
+			expected: []step{{"5", "95", "100", "0.05"}, {"25", "75", "100", "0.0125"}},
+		},
+		{
+			name: "single step within a wide tolerance", stock: "1", target: "0.37", glassware: milliliters(1, 2, 5, 10, 25, 50, 100), tolerance: "0.1",
+			expected: []step{{"10", "15", "25", "0.4"}},
+		},
+		{
+			name: "just inside the tolerance below the target", stock: "100", target: "1", glassware: append(milliliters(1), oddFlask), tolerance: "0.01",
+			expected: []step{{"1", "100.01", "101.01", "0.99000099000099000099"}},
+		},
+		{name: "standard glassware misses by more than the default tolerance", stock: "1", target: "0.37", glassware: milliliters(1, 2, 5, 10, 25, 50, 100), expectedError: true},
+		{name: "nothing close", stock: "1", target: "0.3", glassware: milliliters(1, 10, 100), expectedError: true},
+		{name: "negative tolerance", stock: "1", target: "0.1", glassware: milliliters(1, 10), tolerance: "-0.01", expectedError: true},
+		{name: "tolerance of one", stock: "1", target: "0.1", glassware: milliliters(1, 10), tolerance: "1", expectedError: true},
+		{name: "already there", stock: "1", target: "1", glassware: milliliters(1, 10), expectedError: true},
+		{name: "one size of glassware", stock: "1", target: "0.1", glassware: milliliters(10, 10), expectedError: true},
+		{name: "concentrating", stock: "1", target: "2", glassware: milliliters(1, 10), expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tolerance := decimal.Zero
+			if test.tolerance != "" {
+				tolerance = decimal.RequireFromString(test.tolerance)
+			}
+			plan, err := PlanSerialDilution(decimal.RequireFromString(test.stock), decimal.RequireFromString(test.target), test.glassware, tolerance)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if len(plan) != len(test.expected) {
+				t.Fatalf("Expected %d steps, but got %v", len(test.expected), plan)
+			}
+			for i, s := range test.expected {
+				actual := plan[i]
+				if !actual.Aliquot.Value().Equal(decimal.RequireFromString(s.aliquot)) ||
+					!actual.Diluent.Value().Equal(decimal.RequireFromString(s.diluent)) ||
+					!actual.Final.Value().Equal(decimal.RequireFromString(s.final)) ||
+					!actual.Concentration.Equal(decimal.RequireFromString(s.concentration)) {
+					t.Errorf("Step %d: expected %v, but got %v", i+1, s, actual)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package element
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Dimension is the exponent of each base dimension in a quantity, so a pressure is
+// Mass 1, Length -1, Time -2.
+type Dimension struct {
+	Mass        int
+	Length      int
+	Time        int
+	Amount      int
+	Temperature int
+}
+
+var (
+	massDimension        = Dimension{Mass: 1}
+	lengthDimension      = Dimension{Length: 1}
+	volumeDimension      = Dimension{Length: 3}
+	timeDimension        = Dimension{Time: 1}
+	amountDimension      = Dimension{Amount: 1}
+	temperatureDimension = Dimension{Temperature: 1}
+	forceDimension       = Dimension{Mass: 1, Length: 1, Time: -2}
+	pressureDimension    = Dimension{Mass: 1, Length: -1, Time: -2}
+	energyDimension      = Dimension{Mass: 1, Length: 2, Time: -2}
+)
+
+func (d Dimension) scale(power int) Dimension {
+	return Dimension{d.Mass * power, d.Length * power, d.Time * power, d.Amount * power, d.Temperature * power}
+}
+
+func (d Dimension) add(o Dimension) Dimension {
+	return Dimension{d.Mass + o.Mass, d.Length + o.Length, d.Time + o.Time, d.Amount + o.Amount, d.Temperature + o.Temperature}
+}
+
+func (d Dimension) String() string {
+	var parts []string
+	for _, base := range []struct {
+		symbol string
+		power  int
+	}{{"M", d.Mass}, {"L", d.Length}, {"T", d.Time}, {"N", d.Amount}, {"Θ", d.Temperature}} {
+		switch base.power {
+		case 0:
+		case 1:
+			parts = append(parts, base.symbol)
+		default:
+			parts = append(parts, base.symbol+superscript(base.power))
+		}
+	}
+	if len(parts) == 0 {
+		return "1"
+	}
+	return strings.Join(parts, "·")
+}
+
+// unitDef is a unit's size in the base units g, m, s, mol and K.
+type unitDef struct {
+	symbol    string
+	dimension Dimension
+	factor    *big.Rat
+	prefixed  bool // whether SI prefixes apply
+}
+
+// volumeUnitSymbols names the volume units that are not built from a length.
+var volumeUnitSymbols = map[VolumeUnit]string{
+	Liter:          "L",
+	FluidOunce:     "fl oz",
+	Cup:            "cup",
+	Pint:           "pt",
+	Quart:          "qt",
+	USGallon:       "gal",
+	ImperialGallon: "imp gal",
+}
+
+var massUnits = []MassUnit{Gram, MetricTon, Dalton, Grain, Ounce, Pound, Stone, ShortTon, LongTon, TroyOunce, Slug}
+
+var unitRegistry = newUnitRegistry()
+
+func newUnitRegistry() map[string]unitDef {
+	registry := make(map[string]unitDef)
+	add := func(symbol string, dimension Dimension, factor *big.Rat, prefixed bool) {
+		registry[symbol] = unitDef{symbol: symbol, dimension: dimension, factor: factor, prefixed: prefixed}
+	}
+	for _, unit := range massUnits {
+		add(unit.symbol, massDimension, unit.factor(), unit == Gram || unit == Dalton)
+	}
+	for unit, symbol := range volumeUnitSymbols {
+		liters := decimal.NewFromFloat(float64(unit)).Rat()
+		add(symbol, volumeDimension, liters.Quo(liters, big.NewRat(1000, 1)), unit == Liter)
+	}
+
+	add("m", lengthDimension, ratio("1", ""), true)
+	add("Å", lengthDimension, ratio("1e-10", ""), false)
+	add("in", lengthDimension, ratio("0.0254", ""), false)
+	add("ft", lengthDimension, ratio("0.3048", ""), false)
+	add("yd", lengthDimension, ratio("0.9144", ""), false)
+	add("mi", lengthDimension, ratio("1609.344", ""), false)
+
+	add("s", timeDimension, ratio("1", ""), true)
+	add("min", timeDimension, ratio("60", ""), false)
+	add("h", timeDimension, ratio("3600", ""), false)
+	add("d", timeDimension, ratio("86400", ""), false)
+
+	add("mol", amountDimension, ratio("1", ""), true)
+
+	// Only absolute scales multiply; Celsius and Fahrenheit need an offset.
+	add("K", temperatureDimension, ratio("1", ""), true)
+	add("R", temperatureDimension, ratio("5", "9"), false)
+
+	// Forces, pressures and energies are in grams, so a newton is 1000 g·m/s².
+	add("N", forceDimension, ratio("1000", ""), true)
+	add("lbf", forceDimension, ratio("4448.2216152605", ""), false)
+	add("Pa", pressureDimension, ratio("1000", ""), true)
+	add("bar", pressureDimension, ratio("100000000", ""), true)
+	add("atm", pressureDimension, ratio("101325000", ""), false)
+	add("torr", pressureDimension, ratio("101325000", "760"), false)
+	add("mmHg", pressureDimension, ratio("133322.387415", ""), false)
+	add("psi", pressureDimension, ratio("4448.2216152605", "0.00064516"), false)
+	add("J", energyDimension, ratio("1000", ""), true)
+	add("cal", energyDimension, ratio("4184", ""), true)
+	add("eV", energyDimension, ratio("1.602176634e-16", ""), true)
+	return registry
+}
+
+// ratio reads an exact decimal, divided by per when per is not empty.
+func ratio(value, per string) *big.Rat {
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		panic("invalid unit factor " + value)
+	}
+	if per != "" {
+		r.Quo(r, ratio(per, ""))
+	}
+	return r
+}
+
+// lookupUnit finds a unit by symbol, with an SI prefix if the unit takes one.
+func lookupUnit(symbol string) (unitDef, bool) {
+	if unit, found := unitRegistry[symbol]; found {
+		return unit, true
+	}
+	for _, p := range prefixSymbols {
+		base := strings.TrimPrefix(symbol, p.symbol)
+		if base == symbol {
+			continue
+		}
+		if unit, found := unitRegistry[base]; found && unit.prefixed {
+			return unit.withPrefix(p.prefix)
+		}
+	}
+	return unitDef{}, false
+}
+
+func (u unitDef) withPrefix(prefix Prefix) (unitDef, bool) {
+	if prefix == NoPrefix {
+		return u, true
+	}
+	symbol, found := prefixSymbol(prefix)
+	if !found {
+		return unitDef{}, false
+	}
+	factor := new(big.Rat).Mul(u.factor, decimal.NewFromFloat(float64(prefix)).Rat())
+	return unitDef{symbol: symbol + u.symbol, dimension: u.dimension, factor: factor}, true
+}
+
+func prefixSymbol(prefix Prefix) (string, bool) {
+	if prefix == NoPrefix {
+		return "", true
+	}
+	for _, p := range prefixSymbols {
+		if p.prefix == prefix {
+			return p.symbol, true
+		}
+	}
+	return "", false
+}
+
+// unitPower is a unit raised to a power, such as s in m/s² with power -2.
+type unitPower struct {
+	unit  unitDef
+	power int
+}
+
+// parseUnits reads a unit expression such as "g/mol", "kg·m^2/s^2", "J/(mol·K)" or "cm3".
+// Units are joined by "·", "*" or a space, and everything after a "/" is in the denominator.
+func parseUnits(expression string) ([]unitPower, error) {
+	var units []unitPower
+	for i, part := range strings.Split(superscripts.Replace(expression), "/") {
+		sign := 1
+		if i > 0 {
+			sign = -1
+		}
+		part = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(part), "("), ")"))
+		if part == "1" && i == 0 {
+			continue
+		}
+		if part == "" && i > 0 {
+			return nil, fmt.Errorf("unit %q has nothing after a \"/\"", expression)
+		}
+		for _, token := range unitTokens(part) {
+			unit, power, err := parseUnitToken(token)
+			if err != nil {
+				return nil, fmt.Errorf("unit %q: %v", expression, err)
+			}
+			units = multiplyUnits(units, []unitPower{{unit, sign * power}})
+		}
+	}
+	return units, nil
+}
+
+// unitTokens splits on "·" and "*", and on spaces unless the words name one unit, like "fl oz".
+func unitTokens(part string) []string {
+	var tokens []string
+	for _, group := range strings.FieldsFunc(part, func(r rune) bool { return r == '·' || r == '⋅' || r == '*' }) {
+		group = strings.TrimSpace(group)
+		if _, found := lookupUnit(group); found {
+			tokens = append(tokens, group)
+			continue
+		}
+		tokens = append(tokens, strings.Fields(group)...)
+	}
+	return tokens
+}
+
+func parseUnitToken(token string) (unitDef, int, error) {
+	if unit, found := lookupUnit(token); found {
+		return unit, 1, nil
+	}
+	end := len(token)
+	for end > 0 && isDigit(token[end-1]) {
+		end--
+	}
+	if end > 0 && token[end-1] == '-' {
+		end--
+	}
+	symbol, exponent := strings.TrimSuffix(token[:end], "^"), token[end:]
+	unit, found := lookupUnit(symbol)
+	if !found || exponent == "" || exponent == "-" {
+		return unitDef{}, 0, fmt.Errorf("unknown unit %q", token)
+	}
+	power := 0
+	if _, err := fmt.Sscanf(exponent, "%d", &power); err != nil || power == 0 {
+		return unitDef{}, 0, fmt.Errorf("bad exponent in %q", token)
+	}
+	return unit, power, nil
+}
+
+// multiplyUnits combines two unit lists, adding the powers of units they share.
+func multiplyUnits(a, b []unitPower) []unitPower {
+	result := append([]unitPower{}, a...)
+	for _, up := range b {
+		merged := false
+		for i := range result {
+			if result[i].unit.symbol == up.unit.symbol {
+				result[i].power += up.power
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			result = append(result, up)
+		}
+	}
+	kept := result[:0]
+	for _, up := range result {
+		if up.power != 0 {
+			kept = append(kept, up)
+		}
+	}
+	return kept
+}
+
+func unitsDimension(units []unitPower) Dimension {
+	var d Dimension
+	for _, up := range units {
+		d = d.add(up.unit.dimension.scale(up.power))
+	}
+	return d
+}
+
+// unitsFactor is the size of the combined units in base units.
+func unitsFactor(units []unitPower) *big.Rat {
+	factor := big.NewRat(1, 1)
+	for _, up := range units {
+		for i := 0; i < abs(up.power); i++ {
+			if up.power > 0 {
+				factor.Mul(factor, up.unit.factor)
+			} else {
+				factor.Quo(factor, up.unit.factor)
+			}
+		}
+	}
+	return factor
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func superscript(n int) string {
+	return strings.NewReplacer(
+		"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+		"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹", "-", "⁻",
+	).Replace(fmt.Sprint(n))
+}
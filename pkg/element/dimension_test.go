@@ -0,0 +1,57 @@
+package element
+
+import (
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		expression        string
+		expectedDimension Dimension
+		expectedText      string
+		expectedError     bool
+	}{
+		{expression: "g/mol", expectedDimension: Dimension{Mass: 1, Amount: -1}, expectedText: "g/mol"},
+		{expression: "mol/L", expectedDimension: Dimension{Amount: 1, Length: -3}, expectedText: "mol/L"},
+		{expression: "kg·m^2/s^2", expectedDimension: energyDimension, expectedText: "kg·m²/s²"},
+		{expression: "kg*m²*s⁻²", expectedDimension: energyDimension, expectedText: "kg·m²/s²"},
+		{expression: "J/(mol·K)", expectedDimension: Dimension{Mass: 1, Length: 2, Time: -2, Amount: -1, Temperature: -1}, expectedText: "J/(mol·K)"},
+		{expression: "J/mol/K", expectedDimension: Dimension{Mass: 1, Length: 2, Time: -2, Amount: -1, Temperature: -1}, expectedText: "J/(mol·K)"},
+		{expression: "L atm", expectedDimension: energyDimension, expectedText: "L·atm"},
+		{expression: "cm3", expectedDimension: volumeDimension, expectedText: "cm³"},
+		{expression: "fl oz", expectedDimension: volumeDimension, expectedText: "fl oz"},
+		{expression: "1/s", expectedDimension: Dimension{Time: -1}, expectedText: "1/s"},
+		{expression: "m·m/m", expectedDimension: lengthDimension, expectedText: "m"},
+		{expression: "µmol", expectedDimension: amountDimension, expectedText: "µmol"},
+		{expression: "kPa", expectedDimension: pressureDimension, expectedText: "kPa"},
+		{expression: "furlong", expectedError: true},
+		{expression: "klb", expectedError: true},
+		{expression: "g/", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			units, err := parseUnits(test.expression)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if dimension := unitsDimension(units); dimension != test.expectedDimension {
+				t.Errorf("Expected dimension %v, but got %v", test.expectedDimension, dimension)
+			}
+			if text := formatUnits(units); text != test.expectedText {
+				t.Errorf("Expected %s, but got %s", test.expectedText, text)
+			}
+		})
+	}
+}
+
+func TestDimensionString(t *testing.T) {
+	if text := pressureDimension.String(); text != "M·L⁻¹·T⁻²" {
+		t.Errorf("Expected M·L⁻¹·T⁻², but got %s", text)
+	}
+	if text := (Dimension{}).String(); text != "1" {
+		t.Errorf("Expected 1, but got %s", text)
+	}
+}
@@ -11,6 +11,7 @@ type Element struct {
 	VanDerWaalsRadius float64 // Van der Waals radius (in picometers, pm)
 	Group           int     // The group number(Column) in the periodic table (1-18)
 	Period          int     // The period number(Row) in the periodic table (1-7)
+	Isotopes        []Isotope // Natural isotopes and common radioisotopes
 }
 
 type PeriodicTable struct {
@@ -19,124 +20,124 @@ type PeriodicTable struct {
 
 func NewPeriodicTable() *PeriodicTable {
     elements := []Element{
-        {1, "H", "Hydrogen", decimal.NewFromFloat(1.008), 2.20, 120.0, 1, 1},
-		{2, "He", "Helium", decimal.NewFromFloat(4.002602), 0.0, 140.0, 18, 1},
-		{3, "Li", "Lithium", decimal.NewFromFloat(6.94), 0.98, 182.0, 1, 2},
-		{4, "Be", "Beryllium", decimal.NewFromFloat(9.0122), 1.57, 153.0, 2, 2},
-		{5, "B", "Boron", decimal.NewFromFloat(10.81), 2.04, 192.0, 13, 2},
-		{6, "C", "Carbon", decimal.NewFromFloat(12.011), 2.55, 170.0, 14, 2},
-		{7, "N", "Nitrogen", decimal.NewFromFloat(14.007), 3.04, 155.0, 15, 2},
-		{8, "O", "Oxygen", decimal.NewFromFloat(15.999), 3.44, 152.0, 16, 2},
-		{9, "F", "Fluorine", decimal.NewFromFloat(18.998403163), 3.98, 147.0, 17, 2},
-		{10, "Ne", "Neon", decimal.NewFromFloat(20.1797), 0.0, 154.0, 18, 2},
-		{11, "Na", "Sodium", decimal.NewFromFloat(22.98976928), 0.93, 180.0, 1, 3},
-		{12, "Mg", "Magnesium", decimal.NewFromFloat(24.305), 1.31, 173.0, 2, 3},
-		{13, "Al", "Aluminum", decimal.NewFromFloat(26.9815385), 1.61, 184.0, 13, 3},
-		{14, "Si", "Silicon", decimal.NewFromFloat(28.085), 1.90, 210.0, 14, 3},
-		{15, "P", "Phosphorus", decimal.NewFromFloat(30.973761998), 2.19, 175.0, 15, 3},
-		{16, "S", "Sulfur", decimal.NewFromFloat(32.065), 2.58, 180.0, 16, 3},
-		{17, "Cl", "Chlorine", decimal.NewFromFloat(35.45), 3.16, 175.0, 17, 3},
-		{18, "Ar", "Argon", decimal.NewFromFloat(39.948), 0.0, 188.0, 18, 3},
-		{19, "K", "Potassium", decimal.NewFromFloat(39.0983), 0.82, 275.0, 1, 4},
-		{20, "Ca", "Calcium", decimal.NewFromFloat(40.078), 1.00, 231.0, 2, 4},
-		{21, "Sc", "Scandium", decimal.NewFromFloat(44.955908), 1.36, 184.0, 3, 4},
-		{22, "Ti", "Titanium", decimal.NewFromFloat(47.867), 1.54, 176.0, 4, 4},
-		{23, "V", "Vanadium", decimal.NewFromFloat(50.9415), 1.63, 171.0, 5, 4},
-		{24, "Cr", "Chromium", decimal.NewFromFloat(52.0), 1.66, 139.0, 6, 4},
-		{25, "Mn", "Manganese", decimal.NewFromFloat(54.938044), 1.55, 161.0, 7, 4},
-		{26, "Fe", "Iron", decimal.NewFromFloat(55.845), 1.83, 155.0, 8, 4},
-		{27, "Co", "Cobalt", decimal.NewFromFloat(58.933194), 1.88, 152.0, 9, 4},
-		{28, "Ni", "Nickel", decimal.NewFromFloat(58.6934), 1.91, 149.0, 10, 4},
-		{29, "Cu", "Copper", decimal.NewFromFloat(63.546), 1.90, 135.0, 11, 4},
-		{30, "Zn", "Zinc", decimal.NewFromFloat(65.38), 1.65, 139.0, 12, 4},
-		{31, "Ga", "Gallium", decimal.NewFromFloat(69.723), 1.81, 187.0, 13, 4},
-		{32, "Ge", "Germanium", decimal.NewFromFloat(72.63), 2.01, 211.0, 14, 4},
-		{33, "As", "Arsenic", decimal.NewFromFloat(74.921595), 2.18, 185.0, 15, 4},
-		{34, "Se", "Selenium", decimal.NewFromFloat(78.971), 2.55, 190.0, 16, 4},
-		{35, "Br", "Bromine", decimal.NewFromFloat(79.904), 2.96, 185.0, 17, 4},
-		{36, "Kr", "Krypton", decimal.NewFromFloat(83.798), 3.00, 202.0, 18, 4},
-		{37, "Rb", "Rubidium", decimal.NewFromFloat(85.4678), 0.82, 303.0, 1, 5},
-		{38, "Sr", "Strontium", decimal.NewFromFloat(87.62), 0.95, 249.0, 2, 5},
-		{39, "Y", "Yttrium", decimal.NewFromFloat(88.90584), 1.22, 253.0, 3, 5},
-		{40, "Zr", "Zirconium", decimal.NewFromFloat(91.224), 1.33, 200.0, 4, 5},
-		{41, "Nb", "Niobium", decimal.NewFromFloat(92.90637), 1.60, 198.0, 5, 5},
-		{42, "Mo", "Molybdenum", decimal.NewFromFloat(95.95), 2.16, 200.0, 6, 5},
-		{43, "Tc", "Technetium", decimal.NewFromFloat(98), 2.00, 217.0, 7, 5},
-		{44, "Ru", "Ruthenium", decimal.NewFromFloat(101.07), 2.20, 207.0, 8, 5},
-		{45, "Rh", "Rhodium", decimal.NewFromFloat(102.90550), 2.28, 198.0, 9, 5},
-		{46, "Pd", "Palladium", decimal.NewFromFloat(106.42), 2.20, 163.0, 10, 5},
-		{47, "Ag", "Silver", decimal.NewFromFloat(107.8682), 1.93, 172.0, 11, 5},
-		{48, "Cd", "Cadmium", decimal.NewFromFloat(112.411), 1.69, 158.0, 12, 5},
-		{49, "In", "Indium", decimal.NewFromFloat(114.818), 1.78, 193.0, 13, 5},
-		{50, "Sn", "Tin", decimal.NewFromFloat(118.710), 1.96, 217.0, 14, 5},
-		{51, "Sb", "Antimony", decimal.NewFromFloat(121.760), 2.05, 202.0, 15, 5},
-		{52, "Te", "Tellurium", decimal.NewFromFloat(127.60), 2.01, 206.0, 16, 5},
-		{53, "I", "Iodine", decimal.NewFromFloat(126.90447), 2.66, 198.0, 17, 5},
-		{54, "Xe", "Xenon", decimal.NewFromFloat(131.293), 2.60, 216.0, 18, 5},
-		{55, "Cs", "Cesium", decimal.NewFromFloat(132.90545196), 0.79, 343.0, 1, 6},
-		{56, "Ba", "Barium", decimal.NewFromFloat(137.327), 0.89, 253.0, 2, 6},
-		{57, "La", "Lanthanum", decimal.NewFromFloat(138.90547), 1.10, 262.0, 3, 6},
-		{58, "Ce", "Cerium", decimal.NewFromFloat(140.116), 1.12, 266.0, 3, 6},
-		{59, "Pr", "Praseodymium", decimal.NewFromFloat(140.90766), 1.13, 267.0, 3, 6},
-		{60, "Nd", "Neodymium", decimal.NewFromFloat(144.242), 1.14, 270.0, 3, 6},
-		{61, "Pm", "Promethium", decimal.NewFromFloat(145), 1.13, 271.0, 3, 6},
-		{62, "Sm", "Samarium", decimal.NewFromFloat(150.36), 1.17, 274.0, 3, 6},
-		{63, "Eu", "Europium", decimal.NewFromFloat(151.964), 1.20, 277.0, 3, 6},
-		{64, "Gd", "Gadolinium", decimal.NewFromFloat(157.25), 1.20, 280.0, 3, 6},
-		{65, "Tb", "Terbium", decimal.NewFromFloat(158.92535), 1.23, 282.0, 3, 6},
-		{66, "Dy", "Dysprosium", decimal.NewFromFloat(162.500), 1.22, 285.0, 3, 6},
-		{67, "Ho", "Holmium", decimal.NewFromFloat(164.93033), 1.23, 287.0, 3, 6},
-		{68, "Er", "Erbium", decimal.NewFromFloat(167.259), 1.24, 289.0, 3, 6},
-		{69, "Tm", "Thulium", decimal.NewFromFloat(168.93422), 1.25, 292.0, 3, 6},
-		{70, "Yb", "Ytterbium", decimal.NewFromFloat(173.04), 1.10, 294.0, 3, 6},
-		{71, "Lu", "Lutetium", decimal.NewFromFloat(174.9668), 1.27, 296.0, 3, 6},
-		{72, "Hf", "Hafnium", decimal.NewFromFloat(178.49), 1.30, 208.0, 4, 6},
-		{73, "Ta", "Tantalum", decimal.NewFromFloat(180.94788), 1.50, 200.0, 5, 6},
-		{74, "W", "Tungsten", decimal.NewFromFloat(183.84), 2.36, 193.0, 6, 6},
-		{75, "Re", "Rhenium", decimal.NewFromFloat(186.207), 1.90, 188.0, 7, 6},
-		{76, "Os", "Osmium", decimal.NewFromFloat(190.23), 2.20, 190.0, 8, 6},
-		{77, "Ir", "Iridium", decimal.NewFromFloat(192.217), 2.20, 180.0, 9, 6},
-		{78, "Pt", "Platinum", decimal.NewFromFloat(195.084), 2.28, 177.0, 10, 6},
-		{79, "Au", "Gold", decimal.NewFromFloat(196.966569), 2.54, 144.0, 11, 6},
-		{80, "Hg", "Mercury", decimal.NewFromFloat(200.592), 2.00, 155.0, 12, 6},
-		{81, "Tl", "Thallium", decimal.NewFromFloat(204.38), 1.62, 196.0, 13, 6},
-		{82, "Pb", "Lead", decimal.NewFromFloat(207.2), 2.33, 202.0, 14, 6},
-		{83, "Bi", "Bismuth", decimal.NewFromFloat(208.98040), 2.02, 207.0, 15, 6},
-		{84, "Po", "Polonium", decimal.NewFromFloat(209), 2.00, 202.0, 16, 6},
-		{85, "At", "Astatine", decimal.NewFromFloat(210), 2.2, 202.0, 17, 6},
-		{86, "Rn", "Radon", decimal.NewFromFloat(222), 2.2, 220.0, 18, 6},
-		{87, "Fr", "Francium", decimal.NewFromFloat(223), 0.7, 330.0, 1, 7},
-		{88, "Ra", "Radium", decimal.NewFromFloat(226), 0.9, 215.0, 2, 7},
-		{89, "Ac", "Actinium", decimal.NewFromFloat(227), 1.1, 216.0, 3, 7},
-		{90, "Th", "Thorium", decimal.NewFromFloat(232.03805), 1.3, 232.0, 3, 7},
-		{91, "Pa", "Protactinium", decimal.NewFromFloat(231.03588), 1.5, 231.0, 4, 7},
-		{92, "U", "Uranium", decimal.NewFromFloat(238.02891), 1.38, 244.0, 5, 7},
-		{93, "Np", "Neptunium", decimal.NewFromFloat(237), 1.36, 259.0, 6, 7},
-		{94, "Pu", "Plutonium", decimal.NewFromFloat(244), 1.28, 263.0, 7, 7},
-		{95, "Am", "Americium", decimal.NewFromFloat(243), 1.13, 267.0, 8, 7},
-		{96, "Cm", "Curium", decimal.NewFromFloat(247), 1.3, 273.0, 9, 7},
-		{97, "Bk", "Berkelium", decimal.NewFromFloat(247), 1.3, 276.0, 10, 7},
-		{98, "Cf", "Californium", decimal.NewFromFloat(251), 1.3, 281.0, 11, 7},
-		{99, "Es", "Einsteinium", decimal.NewFromFloat(252), 1.5, 282.0, 12, 7},
-		{100, "Fm", "Fermium", decimal.NewFromFloat(257), 1.6, 287.0, 13, 7},
-		{101, "Md", "Mendelevium", decimal.NewFromFloat(258), 1.7, 290.0, 14, 7},
-		{102, "No", "Nobelium", decimal.NewFromFloat(259), 1.7, 292.0, 15, 7},
-		{103, "Lr", "Lawrencium", decimal.NewFromFloat(262), 1.7, 294.0, 16, 7},
-		{104, "Rf", "Rutherfordium", decimal.NewFromFloat(267), 1.6, 297.0, 4, 7},
-		{105, "Db", "Dubnium", decimal.NewFromFloat(270), 1.6, 300.0, 5, 7},
-		{106, "Sg", "Seaborgium", decimal.NewFromFloat(271), 1.6, 303.0, 6, 7},
-		{107, "Bh", "Bohrium", decimal.NewFromFloat(270), 1.6, 305.0, 7, 7},
-		{108, "Hs", "Hassium", decimal.NewFromFloat(277), 1.6, 310.0, 8, 7},
-		{109, "Mt", "Meitnerium", decimal.NewFromFloat(276), 1.6, 315.0, 9, 7},
-		{110, "Ds", "Darmstadtium", decimal.NewFromFloat(281), 1.6, 318.0, 10, 7},
-		{111, "Rg", "Roentgenium", decimal.NewFromFloat(280), 1.6, 320.0, 11, 7},
-		{112, "Cn", "Copernicium", decimal.NewFromFloat(285), 1.6, 325.0, 12, 7},
-		{113, "Nh", "Nihonium", decimal.NewFromFloat(284), 1.6, 330.0, 13, 7},
-		{114, "Fl", "Flerovium", decimal.NewFromFloat(289), 1.6, 335.0, 14, 7},
-		{115, "Mc", "Moscovium", decimal.NewFromFloat(288), 1.6, 340.0, 15, 7},
-		{116, "Lv", "Livermorium", decimal.NewFromFloat(293), 1.6, 345.0, 16, 7},
-		{117, "Ts", "Tennessine", decimal.NewFromFloat(294), 1.6, 350.0, 17, 7},
-		{118, "Og", "Oganesson", decimal.NewFromFloat(294), 2.0, 360.0, 18, 7},
+        {1, "H", "Hydrogen", decimal.NewFromFloat(1.008), 2.20, 120.0, 1, 1, isotopeData[1]},
+		{2, "He", "Helium", decimal.NewFromFloat(4.002602), 0.0, 140.0, 18, 1, isotopeData[2]},
+		{3, "Li", "Lithium", decimal.NewFromFloat(6.94), 0.98, 182.0, 1, 2, isotopeData[3]},
+		{4, "Be", "Beryllium", decimal.NewFromFloat(9.0122), 1.57, 153.0, 2, 2, isotopeData[4]},
+		{5, "B", "Boron", decimal.NewFromFloat(10.81), 2.04, 192.0, 13, 2, isotopeData[5]},
+		{6, "C", "Carbon", decimal.NewFromFloat(12.011), 2.55, 170.0, 14, 2, isotopeData[6]},
+		{7, "N", "Nitrogen", decimal.NewFromFloat(14.007), 3.04, 155.0, 15, 2, isotopeData[7]},
+		{8, "O", "Oxygen", decimal.NewFromFloat(15.999), 3.44, 152.0, 16, 2, isotopeData[8]},
+		{9, "F", "Fluorine", decimal.NewFromFloat(18.998403163), 3.98, 147.0, 17, 2, isotopeData[9]},
+		{10, "Ne", "Neon", decimal.NewFromFloat(20.1797), 0.0, 154.0, 18, 2, isotopeData[10]},
+		{11, "Na", "Sodium", decimal.NewFromFloat(22.98976928), 0.93, 180.0, 1, 3, isotopeData[11]},
+		{12, "Mg", "Magnesium", decimal.NewFromFloat(24.305), 1.31, 173.0, 2, 3, isotopeData[12]},
+		{13, "Al", "Aluminum", decimal.NewFromFloat(26.9815385), 1.61, 184.0, 13, 3, isotopeData[13]},
+		{14, "Si", "Silicon", decimal.NewFromFloat(28.085), 1.90, 210.0, 14, 3, isotopeData[14]},
+		{15, "P", "Phosphorus", decimal.NewFromFloat(30.973761998), 2.19, 175.0, 15, 3, isotopeData[15]},
+		{16, "S", "Sulfur", decimal.NewFromFloat(32.065), 2.58, 180.0, 16, 3, isotopeData[16]},
+		{17, "Cl", "Chlorine", decimal.NewFromFloat(35.45), 3.16, 175.0, 17, 3, isotopeData[17]},
+		{18, "Ar", "Argon", decimal.NewFromFloat(39.948), 0.0, 188.0, 18, 3, isotopeData[18]},
+		{19, "K", "Potassium", decimal.NewFromFloat(39.0983), 0.82, 275.0, 1, 4, isotopeData[19]},
+		{20, "Ca", "Calcium", decimal.NewFromFloat(40.078), 1.00, 231.0, 2, 4, isotopeData[20]},
+		{21, "Sc", "Scandium", decimal.NewFromFloat(44.955908), 1.36, 184.0, 3, 4, isotopeData[21]},
+		{22, "Ti", "Titanium", decimal.NewFromFloat(47.867), 1.54, 176.0, 4, 4, isotopeData[22]},
+		{23, "V", "Vanadium", decimal.NewFromFloat(50.9415), 1.63, 171.0, 5, 4, isotopeData[23]},
+		{24, "Cr", "Chromium", decimal.NewFromFloat(52.0), 1.66, 139.0, 6, 4, isotopeData[24]},
+		{25, "Mn", "Manganese", decimal.NewFromFloat(54.938044), 1.55, 161.0, 7, 4, isotopeData[25]},
+		{26, "Fe", "Iron", decimal.NewFromFloat(55.845), 1.83, 155.0, 8, 4, isotopeData[26]},
+		{27, "Co", "Cobalt", decimal.NewFromFloat(58.933194), 1.88, 152.0, 9, 4, isotopeData[27]},
+		{28, "Ni", "Nickel", decimal.NewFromFloat(58.6934), 1.91, 149.0, 10, 4, isotopeData[28]},
+		{29, "Cu", "Copper", decimal.NewFromFloat(63.546), 1.90, 135.0, 11, 4, isotopeData[29]},
+		{30, "Zn", "Zinc", decimal.NewFromFloat(65.38), 1.65, 139.0, 12, 4, isotopeData[30]},
+		{31, "Ga", "Gallium", decimal.NewFromFloat(69.723), 1.81, 187.0, 13, 4, isotopeData[31]},
+		{32, "Ge", "Germanium", decimal.NewFromFloat(72.63), 2.01, 211.0, 14, 4, isotopeData[32]},
+		{33, "As", "Arsenic", decimal.NewFromFloat(74.921595), 2.18, 185.0, 15, 4, isotopeData[33]},
+		{34, "Se", "Selenium", decimal.NewFromFloat(78.971), 2.55, 190.0, 16, 4, isotopeData[34]},
+		{35, "Br", "Bromine", decimal.NewFromFloat(79.904), 2.96, 185.0, 17, 4, isotopeData[35]},
+		{36, "Kr", "Krypton", decimal.NewFromFloat(83.798), 3.00, 202.0, 18, 4, isotopeData[36]},
+		{37, "Rb", "Rubidium", decimal.NewFromFloat(85.4678), 0.82, 303.0, 1, 5, isotopeData[37]},
+		{38, "Sr", "Strontium", decimal.NewFromFloat(87.62), 0.95, 249.0, 2, 5, isotopeData[38]},
+		{39, "Y", "Yttrium", decimal.NewFromFloat(88.90584), 1.22, 253.0, 3, 5, isotopeData[39]},
+		{40, "Zr", "Zirconium", decimal.NewFromFloat(91.224), 1.33, 200.0, 4, 5, isotopeData[40]},
+		{41, "Nb", "Niobium", decimal.NewFromFloat(92.90637), 1.60, 198.0, 5, 5, isotopeData[41]},
+		{42, "Mo", "Molybdenum", decimal.NewFromFloat(95.95), 2.16, 200.0, 6, 5, isotopeData[42]},
+		{43, "Tc", "Technetium", decimal.NewFromFloat(98), 2.00, 217.0, 7, 5, isotopeData[43]},
+		{44, "Ru", "Ruthenium", decimal.NewFromFloat(101.07), 2.20, 207.0, 8, 5, isotopeData[44]},
+		{45, "Rh", "Rhodium", decimal.NewFromFloat(102.90550), 2.28, 198.0, 9, 5, isotopeData[45]},
+		{46, "Pd", "Palladium", decimal.NewFromFloat(106.42), 2.20, 163.0, 10, 5, isotopeData[46]},
+		{47, "Ag", "Silver", decimal.NewFromFloat(107.8682), 1.93, 172.0, 11, 5, isotopeData[47]},
+		{48, "Cd", "Cadmium", decimal.NewFromFloat(112.411), 1.69, 158.0, 12, 5, isotopeData[48]},
+		{49, "In", "Indium", decimal.NewFromFloat(114.818), 1.78, 193.0, 13, 5, isotopeData[49]},
+		{50, "Sn", "Tin", decimal.NewFromFloat(118.710), 1.96, 217.0, 14, 5, isotopeData[50]},
+		{51, "Sb", "Antimony", decimal.NewFromFloat(121.760), 2.05, 202.0, 15, 5, isotopeData[51]},
+		{52, "Te", "Tellurium", decimal.NewFromFloat(127.60), 2.01, 206.0, 16, 5, isotopeData[52]},
+		{53, "I", "Iodine", decimal.NewFromFloat(126.90447), 2.66, 198.0, 17, 5, isotopeData[53]},
+		{54, "Xe", "Xenon", decimal.NewFromFloat(131.293), 2.60, 216.0, 18, 5, isotopeData[54]},
+		{55, "Cs", "Cesium", decimal.NewFromFloat(132.90545196), 0.79, 343.0, 1, 6, isotopeData[55]},
+		{56, "Ba", "Barium", decimal.NewFromFloat(137.327), 0.89, 253.0, 2, 6, isotopeData[56]},
+		{57, "La", "Lanthanum", decimal.NewFromFloat(138.90547), 1.10, 262.0, 3, 6, isotopeData[57]},
+		{58, "Ce", "Cerium", decimal.NewFromFloat(140.116), 1.12, 266.0, 3, 6, isotopeData[58]},
+		{59, "Pr", "Praseodymium", decimal.NewFromFloat(140.90766), 1.13, 267.0, 3, 6, isotopeData[59]},
+		{60, "Nd", "Neodymium", decimal.NewFromFloat(144.242), 1.14, 270.0, 3, 6, isotopeData[60]},
+		{61, "Pm", "Promethium", decimal.NewFromFloat(145), 1.13, 271.0, 3, 6, isotopeData[61]},
+		{62, "Sm", "Samarium", decimal.NewFromFloat(150.36), 1.17, 274.0, 3, 6, isotopeData[62]},
+		{63, "Eu", "Europium", decimal.NewFromFloat(151.964), 1.20, 277.0, 3, 6, isotopeData[63]},
+		{64, "Gd", "Gadolinium", decimal.NewFromFloat(157.25), 1.20, 280.0, 3, 6, isotopeData[64]},
+		{65, "Tb", "Terbium", decimal.NewFromFloat(158.92535), 1.23, 282.0, 3, 6, isotopeData[65]},
+		{66, "Dy", "Dysprosium", decimal.NewFromFloat(162.500), 1.22, 285.0, 3, 6, isotopeData[66]},
+		{67, "Ho", "Holmium", decimal.NewFromFloat(164.93033), 1.23, 287.0, 3, 6, isotopeData[67]},
+		{68, "Er", "Erbium", decimal.NewFromFloat(167.259), 1.24, 289.0, 3, 6, isotopeData[68]},
+		{69, "Tm", "Thulium", decimal.NewFromFloat(168.93422), 1.25, 292.0, 3, 6, isotopeData[69]},
+		{70, "Yb", "Ytterbium", decimal.NewFromFloat(173.04), 1.10, 294.0, 3, 6, isotopeData[70]},
+		{71, "Lu", "Lutetium", decimal.NewFromFloat(174.9668), 1.27, 296.0, 3, 6, isotopeData[71]},
+		{72, "Hf", "Hafnium", decimal.NewFromFloat(178.49), 1.30, 208.0, 4, 6, isotopeData[72]},
+		{73, "Ta", "Tantalum", decimal.NewFromFloat(180.94788), 1.50, 200.0, 5, 6, isotopeData[73]},
+		{74, "W", "Tungsten", decimal.NewFromFloat(183.84), 2.36, 193.0, 6, 6, isotopeData[74]},
+		{75, "Re", "Rhenium", decimal.NewFromFloat(186.207), 1.90, 188.0, 7, 6, isotopeData[75]},
+		{76, "Os", "Osmium", decimal.NewFromFloat(190.23), 2.20, 190.0, 8, 6, isotopeData[76]},
+		{77, "Ir", "Iridium", decimal.NewFromFloat(192.217), 2.20, 180.0, 9, 6, isotopeData[77]},
+		{78, "Pt", "Platinum", decimal.NewFromFloat(195.084), 2.28, 177.0, 10, 6, isotopeData[78]},
+		{79, "Au", "Gold", decimal.NewFromFloat(196.966569), 2.54, 144.0, 11, 6, isotopeData[79]},
+		{80, "Hg", "Mercury", decimal.NewFromFloat(200.592), 2.00, 155.0, 12, 6, isotopeData[80]},
+		{81, "Tl", "Thallium", decimal.NewFromFloat(204.38), 1.62, 196.0, 13, 6, isotopeData[81]},
+		{82, "Pb", "Lead", decimal.NewFromFloat(207.2), 2.33, 202.0, 14, 6, isotopeData[82]},
+		{83, "Bi", "Bismuth", decimal.NewFromFloat(208.98040), 2.02, 207.0, 15, 6, isotopeData[83]},
+		{84, "Po", "Polonium", decimal.NewFromFloat(209), 2.00, 202.0, 16, 6, isotopeData[84]},
+		{85, "At", "Astatine", decimal.NewFromFloat(210), 2.2, 202.0, 17, 6, isotopeData[85]},
+		{86, "Rn", "Radon", decimal.NewFromFloat(222), 2.2, 220.0, 18, 6, isotopeData[86]},
+		{87, "Fr", "Francium", decimal.NewFromFloat(223), 0.7, 330.0, 1, 7, isotopeData[87]},
+		{88, "Ra", "Radium", decimal.NewFromFloat(226), 0.9, 215.0, 2, 7, isotopeData[88]},
+		{89, "Ac", "Actinium", decimal.NewFromFloat(227), 1.1, 216.0, 3, 7, isotopeData[89]},
+		{90, "Th", "Thorium", decimal.NewFromFloat(232.03805), 1.3, 232.0, 3, 7, isotopeData[90]},
+		{91, "Pa", "Protactinium", decimal.NewFromFloat(231.03588), 1.5, 231.0, 4, 7, isotopeData[91]},
+		{92, "U", "Uranium", decimal.NewFromFloat(238.02891), 1.38, 244.0, 5, 7, isotopeData[92]},
+		{93, "Np", "Neptunium", decimal.NewFromFloat(237), 1.36, 259.0, 6, 7, isotopeData[93]},
+		{94, "Pu", "Plutonium", decimal.NewFromFloat(244), 1.28, 263.0, 7, 7, isotopeData[94]},
+		{95, "Am", "Americium", decimal.NewFromFloat(243), 1.13, 267.0, 8, 7, isotopeData[95]},
+		{96, "Cm", "Curium", decimal.NewFromFloat(247), 1.3, 273.0, 9, 7, isotopeData[96]},
+		{97, "Bk", "Berkelium", decimal.NewFromFloat(247), 1.3, 276.0, 10, 7, isotopeData[97]},
+		{98, "Cf", "Californium", decimal.NewFromFloat(251), 1.3, 281.0, 11, 7, isotopeData[98]},
+		{99, "Es", "Einsteinium", decimal.NewFromFloat(252), 1.5, 282.0, 12, 7, isotopeData[99]},
+		{100, "Fm", "Fermium", decimal.NewFromFloat(257), 1.6, 287.0, 13, 7, isotopeData[100]},
+		{101, "Md", "Mendelevium", decimal.NewFromFloat(258), 1.7, 290.0, 14, 7, isotopeData[101]},
+		{102, "No", "Nobelium", decimal.NewFromFloat(259), 1.7, 292.0, 15, 7, isotopeData[102]},
+		{103, "Lr", "Lawrencium", decimal.NewFromFloat(262), 1.7, 294.0, 16, 7, isotopeData[103]},
+		{104, "Rf", "Rutherfordium", decimal.NewFromFloat(267), 1.6, 297.0, 4, 7, isotopeData[104]},
+		{105, "Db", "Dubnium", decimal.NewFromFloat(270), 1.6, 300.0, 5, 7, isotopeData[105]},
+		{106, "Sg", "Seaborgium", decimal.NewFromFloat(271), 1.6, 303.0, 6, 7, isotopeData[106]},
+		{107, "Bh", "Bohrium", decimal.NewFromFloat(270), 1.6, 305.0, 7, 7, isotopeData[107]},
+		{108, "Hs", "Hassium", decimal.NewFromFloat(277), 1.6, 310.0, 8, 7, isotopeData[108]},
+		{109, "Mt", "Meitnerium", decimal.NewFromFloat(276), 1.6, 315.0, 9, 7, isotopeData[109]},
+		{110, "Ds", "Darmstadtium", decimal.NewFromFloat(281), 1.6, 318.0, 10, 7, isotopeData[110]},
+		{111, "Rg", "Roentgenium", decimal.NewFromFloat(280), 1.6, 320.0, 11, 7, isotopeData[111]},
+		{112, "Cn", "Copernicium", decimal.NewFromFloat(285), 1.6, 325.0, 12, 7, isotopeData[112]},
+		{113, "Nh", "Nihonium", decimal.NewFromFloat(284), 1.6, 330.0, 13, 7, isotopeData[113]},
+		{114, "Fl", "Flerovium", decimal.NewFromFloat(289), 1.6, 335.0, 14, 7, isotopeData[114]},
+		{115, "Mc", "Moscovium", decimal.NewFromFloat(288), 1.6, 340.0, 15, 7, isotopeData[115]},
+		{116, "Lv", "Livermorium", decimal.NewFromFloat(293), 1.6, 345.0, 16, 7, isotopeData[116]},
+		{117, "Ts", "Tennessine", decimal.NewFromFloat(294), 1.6, 350.0, 17, 7, isotopeData[117]},
+		{118, "Og", "Oganesson", decimal.NewFromFloat(294), 2.0, 360.0, 18, 7, isotopeData[118]},
     }
     return &PeriodicTable{Elements:elements}
 }
@@ -0,0 +1,143 @@
+package element
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultRatioTolerance is how far a scaled mole ratio may sit from a whole number and still round to it.
+var DefaultRatioTolerance = decimal.NewFromFloat(0.1)
+
+// maxRatioMultiplier is the largest factor tried when clearing ratios such as 1.5, 1.33 or 1.25.
+const maxRatioMultiplier = 8
+
+// EmpiricalFormula finds the simplest whole-number formula from element masses keyed by symbol.
+// The masses may be grams or mass percents, since only their ratios matter.
+// A zero tolerance uses DefaultRatioTolerance.
+func EmpiricalFormula(composition map[string]decimal.Decimal, pt *PeriodicTable, tolerance decimal.Decimal) (Compound, error) {
+	if len(composition) == 0 {
+		return Compound{}, fmt.Errorf("no element masses passed")
+	}
+	if tolerance.IsZero() {
+		tolerance = DefaultRatioTolerance
+	}
+	var elements []ElementMoles
+	smallest := decimal.Zero
+	for symbol, mass := range composition {
+		element, found := pt.FindElementBySymbol(symbol)
+		if !found {
+			return Compound{}, fmt.Errorf("element %s not found in the periodic table", symbol)
+		}
+		if !mass.IsPositive() {
+			return Compound{}, fmt.Errorf("mass of %s must be positive, got %v", symbol, mass)
+		}
+		moles := mass.Div(element.AtomicWeight)
+		if smallest.IsZero() || moles.LessThan(smallest) {
+			smallest = moles
+		}
+		elements = append(elements, ElementMoles{Element: *element, Moles: moles})
+	}
+	for i := range elements {
+		elements[i].Moles = elements[i].Moles.Div(smallest)
+	}
+	if err := roundRatios(elements, tolerance); err != nil {
+		return Compound{}, err
+	}
+	return newHillCompound(elements)
+}
+
+// MolecularFormula scales an empirical formula up to match a measured molar mass.
+// The multiple must land within tolerance of a whole number; a zero tolerance uses DefaultRatioTolerance.
+func MolecularFormula(empirical Compound, molarMass decimal.Decimal, tolerance decimal.Decimal) (Compound, error) {
+	if tolerance.IsZero() {
+		tolerance = DefaultRatioTolerance
+	}
+	if err := empirical.getMolarMass(); err != nil {
+		return Compound{}, err
+	}
+	if !molarMass.IsPositive() {
+		return Compound{}, fmt.Errorf("molar mass must be positive, got %v", molarMass)
+	}
+	multiple := molarMass.Div(empirical.MolarMass)
+	n := multiple.Round(0)
+	if n.LessThan(decimal.NewFromInt(1)) || multiple.Sub(n).Abs().GreaterThan(tolerance) {
+		return Compound{}, fmt.Errorf("molar mass %v is not a whole multiple of %s (%v g/mol)", molarMass, empirical.Symbol, empirical.MolarMass)
+	}
+	elements := make([]ElementMoles, len(empirical.Elements))
+	for i, em := range empirical.Elements {
+		elements[i] = ElementMoles{Element: em.Element, Moles: em.Moles.Mul(n), Isotope: em.Isotope}
+	}
+	return newHillCompound(elements)
+}
+
+// roundRatios multiplies mole ratios by the smallest factor that brings them all within tolerance
+// of whole numbers, then rounds them.
+func roundRatios(elements []ElementMoles, tolerance decimal.Decimal) error {
+	for multiplier := int64(1); multiplier <= maxRatioMultiplier; multiplier++ {
+		factor := decimal.NewFromInt(multiplier)
+		fits := true
+		for _, em := range elements {
+			scaled := em.Moles.Mul(factor)
+			if scaled.Sub(scaled.Round(0)).Abs().GreaterThan(tolerance) {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			for i := range elements {
+				elements[i].Moles = elements[i].Moles.Mul(factor).Round(0)
+			}
+			return nil
+		}
+	}
+	ratios := make([]string, len(elements))
+	for i, em := range elements {
+		ratios[i] = em.Element.Symbol + ":" + em.Moles.StringFixed(3)
+	}
+	return fmt.Errorf("mole ratios %s do not reduce to whole numbers within %v", strings.Join(ratios, " "), tolerance)
+}
+
+// newHillCompound orders the elements by the Hill system and builds the compound's symbol and molar mass.
+func newHillCompound(elements []ElementMoles) (Compound, error) {
+	sortHill(elements)
+	var symbol strings.Builder
+	for _, em := range elements {
+		symbol.WriteString(em.Element.Symbol)
+		if !em.Moles.Equal(decimal.NewFromInt(1)) {
+			symbol.WriteString(em.Moles.String())
+		}
+	}
+	compound := Compound{Symbol: symbol.String(), Elements: elements}
+	if err := compound.getMolarMass(); err != nil {
+		return Compound{}, err
+	}
+	return compound, nil
+}
+
+// sortHill puts carbon first and hydrogen second when carbon is present, then the rest alphabetically.
+func sortHill(elements []ElementMoles) {
+	hasCarbon := false
+	for _, em := range elements {
+		hasCarbon = hasCarbon || em.Element.Symbol == "C"
+	}
+	rank := func(symbol string) int {
+		switch {
+		case hasCarbon && symbol == "C":
+			return 0
+		case hasCarbon && symbol == "H":
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		ri, rj := rank(elements[i].Element.Symbol), rank(elements[j].Element.Symbol)
+		if ri != rj {
+			return ri < rj
+		}
+		return elements[i].Element.Symbol < elements[j].Element.Symbol
+	})
+}
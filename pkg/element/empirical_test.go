@@ -0,0 +1,92 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestEmpiricalFormula(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		name          string
+		composition   map[string]string
+		tolerance     string
+		expected      string
+		expectedError bool
+	}{
+		{name: "glucose percents", composition: map[string]string{"C": "40.00", "H": "6.71", "O": "53.29"}, expected: "CH2O"},
+		{name: "ratio of 1.5", composition: map[string]string{"Fe": "69.94", "O": "30.06"}, expected: "Fe2O3"},
+		{name: "ratio of 1.33", composition: map[string]string{"Fe": "72.36", "O": "27.64"}, expected: "Fe3O4"},
+		{name: "ratio of 1.25", composition: map[string]string{"P": "43.64", "O": "56.36"}, expected: "O5P2"},
+		{name: "grams instead of percents", composition: map[string]string{"Na": "2.299", "Cl": "3.545"}, expected: "ClNa"},
+		{name: "tight tolerance rejects noisy data", composition: map[string]string{"C": "40.00", "H": "7.40", "O": "53.29"}, tolerance: "0.01", expectedError: true},
+		{name: "unknown element", composition: map[string]string{"Xx": "50", "O": "50"}, expectedError: true},
+		{name: "zero mass", composition: map[string]string{"C": "0", "O": "50"}, expectedError: true},
+		{name: "empty", composition: map[string]string{}, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			composition := make(map[string]decimal.Decimal)
+			for symbol, mass := range test.composition {
+				composition[symbol] = decimal.RequireFromString(mass)
+			}
+			tolerance := decimal.Zero
+			if test.tolerance != "" {
+				tolerance = decimal.RequireFromString(test.tolerance)
+			}
+			result, err := EmpiricalFormula(composition, pt, tolerance)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if result.Symbol != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, result.Symbol)
+			}
+			if !result.MolarMass.IsPositive() {
+				t.Errorf("Expected a molar mass, but got %v", result.MolarMass)
+			}
+		})
+	}
+}
+
+func TestMolecularFormula(t *testing.T) {
+	pt := NewPeriodicTable()
+	empirical, err := NewCompound("CH2O", pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tests := []struct {
+		name          string
+		molarMass     string
+		tolerance     string
+		expected      string
+		expectedError bool
+	}{
+		{name: "glucose", molarMass: "180.16", tolerance: "0", expected: "C6H12O6"},
+		{name: "formaldehyde", molarMass: "30.03", tolerance: "0", expected: "CH2O"},
+		{name: "acetic acid", molarMass: "60.05", tolerance: "0", expected: "C2H4O2"},
+		{name: "rough measurement", molarMass: "186", tolerance: "0", expectedError: true},
+		{name: "rough measurement with a wider tolerance", molarMass: "186", tolerance: "0.25", expected: "C6H12O6"},
+		{name: "tighter tolerance", molarMass: "181", tolerance: "0.01", expectedError: true},
+		{name: "half multiple", molarMass: "75", tolerance: "0", expectedError: true},
+		{name: "below the empirical mass", molarMass: "10", tolerance: "0", expectedError: true},
+		{name: "zero", molarMass: "0", tolerance: "0", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := MolecularFormula(empirical, decimal.RequireFromString(test.molarMass), decimal.RequireFromString(test.tolerance))
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if result.Symbol != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, result.Symbol)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package element
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrImpossibleEquation is returned when no positive set of coefficients conserves every element and charge.
+var ErrImpossibleEquation = errors.New("equation cannot be balanced")
+
+// ErrUnderdeterminedEquation is returned when the equation combines more than one independent reaction.
+var ErrUnderdeterminedEquation = errors.New("equation has more than one independent balance")
+
+// arrows separate reactants from products; longer arrows are checked first.
+var arrows = []string{"<=>", "<->", "⇌", "→", "-->", "->", "=>", "="}
+
+var leadingCoefficient = regexp.MustCompile(`^(\d+)\s*`)
+var stateSuffix = regexp.MustCompile(`\((s|l|g|aq)\)$`)
+var statePrefix = regexp.MustCompile(`^\((s|l|g|aq)\)`)
+
+// ReactionTerm is one species in a reaction with its stoichiometric coefficient.
+type ReactionTerm struct {
+	Coefficient int64
+	Species     Species
+	State       string // physical state such as "aq" or "s", if one was written
+}
+
+// Reaction is a chemical equation of reactants turning into products.
+type Reaction struct {
+	Reactants []ReactionTerm
+	Products  []ReactionTerm
+}
+
+// ParseEquation reads an equation such as "Fe + O2 -> Fe2O3" or "2H2 + O2 = 2H2O".
+// Terms are separated by "+", which must have spaces around it or be followed by the next
+// formula, so charges like "Fe3+ + e- -> Fe2+" stay attached to their species.
+// Missing coefficients default to 1; states such as "(aq)" are kept on the term.
+func ParseEquation(equation string, pt *PeriodicTable) (Reaction, error) {
+	var sides []string
+	for _, arrow := range arrows {
+		if strings.Contains(equation, arrow) {
+			sides = strings.Split(equation, arrow)
+			break
+		}
+	}
+	if len(sides) == 0 {
+		return Reaction{}, fmt.Errorf("equation %q has no arrow, expected one of %s", equation, strings.Join(arrows, " "))
+	}
+	if len(sides) != 2 {
+		return Reaction{}, fmt.Errorf("equation %q needs exactly one arrow", equation)
+	}
+	reactants, err := parseTerms(sides[0], pt)
+	if err != nil {
+		return Reaction{}, err
+	}
+	products, err := parseTerms(sides[1], pt)
+	if err != nil {
+		return Reaction{}, err
+	}
+	return Reaction{Reactants: reactants, Products: products}, nil
+}
+
+func parseTerms(side string, pt *PeriodicTable) ([]ReactionTerm, error) {
+	var terms []ReactionTerm
+	for _, text := range splitTerms(side) {
+		term, err := parseTerm(text, pt)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("each side of an equation needs at least one species")
+	}
+	return terms, nil
+}
+
+// splitTerms splits one side of an equation on the plus signs that separate species.
+func splitTerms(side string) []string {
+	var terms []string
+	start := 0
+	for i := 0; i < len(side); i++ {
+		if side[i] != '+' {
+			continue
+		}
+		spaced := i > 0 && side[i-1] == ' ' && i+1 < len(side) && side[i+1] == ' '
+		// A "+" right before a state, as in "Ag+(aq)", is the charge of that species.
+		charge := statePrefix.MatchString(side[i+1:])
+		if spaced || (i+1 < len(side) && beginsTerm(side[i+1]) && !charge) {
+			terms = append(terms, strings.TrimSpace(side[start:i]))
+			start = i + 1
+		}
+	}
+	terms = append(terms, strings.TrimSpace(side[start:]))
+	if len(terms) == 1 && terms[0] == "" {
+		return nil
+	}
+	return terms
+}
+
+func beginsTerm(c byte) bool {
+	return isUpper(c) || isDigit(c) || closers[c] != 0 || c == '^' || c == 'e'
+}
+
+func parseTerm(text string, pt *PeriodicTable) (ReactionTerm, error) {
+	term := ReactionTerm{Coefficient: 1}
+	if match := leadingCoefficient.FindStringSubmatch(text); match != nil {
+		coefficient, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || coefficient == 0 {
+			return ReactionTerm{}, fmt.Errorf("coefficient %s of %q must be a positive whole number", match[1], text)
+		}
+		term.Coefficient = coefficient
+		text = text[len(match[0]):]
+	}
+	if match := stateSuffix.FindStringSubmatch(text); match != nil {
+		term.State = match[1]
+		text = strings.TrimRightFunc(text[:len(text)-len(match[0])], unicode.IsSpace)
+	}
+	species, err := NewSpecies(text, pt)
+	if err != nil {
+		return ReactionTerm{}, err
+	}
+	term.Species = species
+	return term, nil
+}
+
+// Balance sets the smallest whole-number coefficients that conserve every element, isotope and charge.
+// It solves for the nullspace of the composition matrix in exact rational arithmetic.
+func (r *Reaction) Balance() error {
+	terms := r.terms()
+	matrix := r.compositionMatrix()
+	rank, pivots := reduceRowEchelon(matrix, len(terms))
+	switch free := len(terms) - rank; {
+	case free == 0:
+		return fmt.Errorf("%w: only the trivial solution conserves every element", ErrImpossibleEquation)
+	case free > 1:
+		return fmt.Errorf("%w: %d independent reactions are mixed together", ErrUnderdeterminedEquation, free)
+	}
+
+	// With one free column, set it to 1 and read every pivot variable from its row.
+	freeColumn := 0
+	for isPivot(pivots, freeColumn) {
+		freeColumn++
+	}
+	solution := make([]*big.Rat, len(terms))
+	solution[freeColumn] = big.NewRat(1, 1)
+	for row, column := range pivots {
+		solution[column] = new(big.Rat).Neg(matrix[row][freeColumn])
+	}
+
+	coefficients := integerCoefficients(solution)
+	sign := coefficients[0].Sign()
+	for i, coefficient := range coefficients {
+		if coefficient.Sign() == 0 {
+			return fmt.Errorf("%w: %s cannot take part in the reaction", ErrImpossibleEquation, terms[i].Species.Symbol)
+		}
+		if coefficient.Sign() != sign {
+			return fmt.Errorf("%w: %s is on the wrong side of the equation", ErrImpossibleEquation, terms[i].Species.Symbol)
+		}
+	}
+	for i := range r.Reactants {
+		r.Reactants[i].Coefficient = new(big.Int).Abs(coefficients[i]).Int64()
+	}
+	for i := range r.Products {
+		r.Products[i].Coefficient = new(big.Int).Abs(coefficients[len(r.Reactants)+i]).Int64()
+	}
+	return nil
+}
+
+// IsBalanced reports whether the current coefficients conserve every element, isotope and charge.
+func (r Reaction) IsBalanced() bool {
+	terms := r.terms()
+	for _, row := range r.compositionMatrix() {
+		total := new(big.Rat)
+		for i, value := range row {
+			total.Add(total, new(big.Rat).Mul(value, new(big.Rat).SetInt64(terms[i].Coefficient)))
+		}
+		if total.Sign() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Reaction) String() string {
+	return formatTerms(r.Reactants) + " -> " + formatTerms(r.Products)
+}
+
+func formatTerms(terms []ReactionTerm) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term.Species.Symbol
+		if term.State != "" {
+			parts[i] += "(" + term.State + ")"
+		}
+		if term.Coefficient != 1 {
+			parts[i] = strconv.FormatInt(term.Coefficient, 10) + parts[i]
+		}
+	}
+	return strings.Join(parts, " + ")
+}
+
+// terms lists reactants then products.
+func (r Reaction) terms() []ReactionTerm {
+	return append(append([]ReactionTerm{}, r.Reactants...), r.Products...)
+}
+
+// compositionMatrix has a row per element (or labelled isotope) plus one for charge
+// and a column per term; product columns are negated so a balanced reaction sums to zero.
+func (r Reaction) compositionMatrix() [][]*big.Rat {
+	terms := r.terms()
+	rowOf := make(map[atomKey]int)
+	var matrix [][]*big.Rat
+	newRow := func() []*big.Rat {
+		row := make([]*big.Rat, len(terms))
+		for i := range row {
+			row[i] = new(big.Rat)
+		}
+		return row
+	}
+	charges := newRow()
+	for i, term := range terms {
+		sign := big.NewRat(1, 1)
+		if i >= len(r.Reactants) {
+			sign = big.NewRat(-1, 1)
+		}
+		for _, em := range term.Species.Elements {
+			key := atomKey{symbol: em.Element.Symbol}
+			if em.Isotope != nil {
+				key.massNumber = em.Isotope.MassNumber
+			}
+			if _, seen := rowOf[key]; !seen {
+				rowOf[key] = len(matrix)
+				matrix = append(matrix, newRow())
+			}
+			cell := matrix[rowOf[key]][i]
+			cell.Add(cell, new(big.Rat).Mul(sign, em.Moles.Rat()))
+		}
+		charges[i].Mul(sign, big.NewRat(int64(term.Species.Charge), 1))
+	}
+	return append(matrix, charges)
+}
+
+// reduceRowEchelon reduces the matrix in place and returns its rank and the pivot column of each pivot row.
+func reduceRowEchelon(matrix [][]*big.Rat, columns int) (int, []int) {
+	var pivots []int
+	row := 0
+	for column := 0; column < columns && row < len(matrix); column++ {
+		pivot := -1
+		for i := row; i < len(matrix); i++ {
+			if matrix[i][column].Sign() != 0 {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		matrix[row], matrix[pivot] = matrix[pivot], matrix[row]
+		scale := new(big.Rat).Inv(matrix[row][column])
+		for j := range matrix[row] {
+			matrix[row][j].Mul(matrix[row][j], scale)
+		}
+		for i := range matrix {
+			if i == row || matrix[i][column].Sign() == 0 {
+				continue
+			}
+			factor := new(big.Rat).Set(matrix[i][column])
+			for j := range matrix[i] {
+				matrix[i][j].Sub(matrix[i][j], new(big.Rat).Mul(factor, matrix[row][j]))
+			}
+		}
+		pivots = append(pivots, column)
+		row++
+	}
+	return row, pivots
+}
+
+func isPivot(pivots []int, column int) bool {
+	for _, pivot := range pivots {
+		if pivot == column {
+			return true
+		}
+	}
+	return false
+}
+
+// integerCoefficients scales a rational vector to the smallest whole numbers with the same ratios.
+func integerCoefficients(solution []*big.Rat) []*big.Int {
+	lcm := big.NewInt(1)
+	for _, value := range solution {
+		denominator := value.Denom()
+		gcd := new(big.Int).GCD(nil, nil, lcm, denominator)
+		lcm.Mul(lcm, new(big.Int).Div(denominator, gcd))
+	}
+	coefficients := make([]*big.Int, len(solution))
+	gcd := new(big.Int)
+	for i, value := range solution {
+		scaled := new(big.Rat).Mul(value, new(big.Rat).SetInt(lcm))
+		coefficients[i] = new(big.Int).Set(scaled.Num())
+		gcd.GCD(nil, nil, gcd, new(big.Int).Abs(coefficients[i]))
+	}
+	for _, coefficient := range coefficients {
+		coefficient.Div(coefficient, gcd)
+	}
+	return coefficients
+}
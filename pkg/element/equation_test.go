@@ -0,0 +1,106 @@
+package element
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBalanceEquation(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		equation string
+		expected string
+	}{
+		{equation: "Fe + O2 -> Fe2O3", expected: "4Fe + 3O2 -> 2Fe2O3"},
+		{equation: "C3H8 + O2 -> CO2 + H2O", expected: "C3H8 + 5O2 -> 3CO2 + 4H2O"},
+		{equation: "KMnO4 + HCl -> KCl + MnCl2 + H2O + Cl2", expected: "2KMnO4 + 16HCl -> 2KCl + 2MnCl2 + 8H2O + 5Cl2"},
+		{equation: "Ca(OH)2 + H3PO4 → Ca3(PO4)2 + H2O", expected: "3Ca(OH)2 + 2H3PO4 -> Ca3(PO4)2 + 6H2O"},
+		{equation: "MnO4- + Fe2+ + H+ -> Mn2+ + Fe3+ + H2O", expected: "MnO4- + 5Fe2+ + 8H+ -> Mn2+ + 5Fe3+ + 4H2O"},
+		{equation: "Fe + O2 --> Fe2O3", expected: "4Fe + 3O2 -> 2Fe2O3"},
+		{equation: "Cu + Ag+ = Cu2+ + Ag", expected: "Cu + 2Ag+ -> Cu2+ + 2Ag"},
+		{equation: "Fe3+ + e- -> Fe2+", expected: "Fe3+ + e- -> Fe2+"},
+		{equation: "NH4++OH-<=>NH3+H2O", expected: "NH4+ + OH- -> NH3 + H2O"},
+		{equation: "NaCl(aq) + AgNO3(aq) -> AgCl(s) + NaNO3(aq)", expected: "NaCl(aq) + AgNO3(aq) -> AgCl(s) + NaNO3(aq)"},
+		{equation: "Ag+(aq) + Cl-(aq) -> AgCl(s)", expected: "Ag+(aq) + Cl-(aq) -> AgCl(s)"},
+		{equation: "Cu2+(aq) + Zn(s) -> Cu(s) + Zn2+(aq)", expected: "Cu2+(aq) + Zn(s) -> Cu(s) + Zn2+(aq)"},
+		{equation: "Fe3+(aq)+Cu(s) -> Fe2+(aq)+Cu2+(aq)", expected: "2Fe3+(aq) + Cu(s) -> 2Fe2+(aq) + Cu2+(aq)"},
+		{equation: "CuSO4·5H2O -> CuSO4 + H2O", expected: "CuSO4·5H2O -> CuSO4 + 5H2O"},
+		{equation: "2H2 + 2O2 -> 2H2O", expected: "2H2 + O2 -> 2H2O"},
+	}
+	for _, test := range tests {
+		t.Run(test.equation, func(t *testing.T) {
+			reaction, err := ParseEquation(test.equation, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := reaction.Balance(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if reaction.String() != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, reaction.String())
+			}
+			if !reaction.IsBalanced() {
+				t.Errorf("Expected %s to be balanced", reaction)
+			}
+		})
+	}
+}
+
+func TestBalanceErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		equation string
+		expected error
+	}{
+		{equation: "H2 -> O2", expected: ErrImpossibleEquation},
+		{equation: "H2O + H2 -> O2", expected: ErrImpossibleEquation},
+		{equation: "Na + Cl2 -> NaCl + Ar", expected: ErrImpossibleEquation},
+		{equation: "Fe2+ -> Fe3+", expected: ErrImpossibleEquation},
+		{equation: "H2 + O2 -> H2O + H2O2", expected: ErrUnderdeterminedEquation},
+	}
+	for _, test := range tests {
+		t.Run(test.equation, func(t *testing.T) {
+			reaction, err := ParseEquation(test.equation, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if err := reaction.Balance(); !errors.Is(err, test.expected) {
+				t.Errorf("Expected %v, but got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestParseEquationErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	for _, equation := range []string{"H2 + O2", "H2 -> O2 -> H2O", " -> H2O", "0H2 -> H2", "H2 + Xx -> H2"} {
+		t.Run(equation, func(t *testing.T) {
+			if _, err := ParseEquation(equation, pt); err == nil {
+				t.Errorf("Expected an error for %q", equation)
+			}
+		})
+	}
+}
+
+func TestParseEquationListsArrows(t *testing.T) {
+	_, err := ParseEquation("Fe + O2 ~> Fe2O3", NewPeriodicTable())
+	if err == nil {
+		t.Fatalf("Expected an error for an unknown arrow")
+	}
+	for _, arrow := range []string{"->", "→", "=", "<=>", "⇌"} {
+		if !strings.Contains(err.Error(), arrow) {
+			t.Errorf("Expected %q to list the %s arrow", err, arrow)
+		}
+	}
+}
+
+func TestIsBalanced(t *testing.T) {
+	reaction, err := ParseEquation("2H2 + O2 -> H2O", NewPeriodicTable())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reaction.IsBalanced() {
+		t.Errorf("Expected %s not to be balanced", reaction)
+	}
+}
@@ -0,0 +1,117 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// MonoisotopicMass sums the mass of each atom's most abundant isotope.
+// Isotope-labelled atoms use the mass of their label.
+func (c Compound) MonoisotopicMass() (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, em := range c.Elements {
+		isotope, err := em.principalIsotope()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		total = total.Add(isotope.ExactMass.Mul(em.Moles))
+	}
+	return total, nil
+}
+
+// NominalMass sums the integer mass number of each atom's most abundant isotope.
+func (c Compound) NominalMass() (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, em := range c.Elements {
+		isotope, err := em.principalIsotope()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		total = total.Add(decimal.NewFromInt(int64(isotope.MassNumber)).Mul(em.Moles))
+	}
+	return total, nil
+}
+
+// MostAbundantMass is the mass of the single most probable isotopologue.
+// Elements are independent, so the most probable isotope mix is found for each one separately.
+func (c Compound) MostAbundantMass() (decimal.Decimal, error) {
+	total := decimal.Zero
+	for _, em := range c.Elements {
+		count, err := em.atomCount()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		if em.Isotope != nil {
+			total = total.Add(em.Isotope.ExactMass.Mul(decimal.NewFromInt(count)))
+			continue
+		}
+		isotopes := em.Element.naturalIsotopes()
+		if len(isotopes) == 0 {
+			return decimal.Zero, fmt.Errorf("no natural isotopes of %s", em.Element.Symbol)
+		}
+		for i, n := range multinomialMode(count, isotopes) {
+			total = total.Add(isotopes[i].ExactMass.Mul(decimal.NewFromInt(n)))
+		}
+	}
+	return total, nil
+}
+
+// principalIsotope is the labelled isotope, or the element's most abundant one.
+func (em ElementMoles) principalIsotope() (*Isotope, error) {
+	if _, err := em.atomCount(); err != nil {
+		return nil, err
+	}
+	if em.Isotope != nil {
+		return em.Isotope, nil
+	}
+	isotope, found := em.Element.MostAbundantIsotope()
+	if !found {
+		return nil, fmt.Errorf("no natural isotopes of %s", em.Element.Symbol)
+	}
+	return isotope, nil
+}
+
+// atomCount returns the number of atoms, which must be a whole number for isotope work.
+func (em ElementMoles) atomCount() (int64, error) {
+	if !em.Moles.IsInteger() || em.Moles.IsNegative() {
+		return 0, fmt.Errorf("%v atoms of %s is not a whole number", em.Moles, em.Element.Symbol)
+	}
+	return em.Moles.IntPart(), nil
+}
+
+// multinomialMode finds how many of n atoms take each isotope in the most probable combination.
+// It starts from the expected counts and moves one atom at a time while that raises the probability.
+func multinomialMode(n int64, isotopes []Isotope) []int64 {
+	p := make([]float64, len(isotopes))
+	counts := make([]int64, len(isotopes))
+	var assigned int64
+	for i, isotope := range isotopes {
+		p[i] = isotope.Abundance.InexactFloat64()
+		counts[i] = int64(float64(n) * p[i])
+		assigned += counts[i]
+	}
+	for ; assigned < n; assigned++ {
+		best := 0
+		for i := range p {
+			if p[i]*float64(n)-float64(counts[i]) > p[best]*float64(n)-float64(counts[best]) {
+				best = i
+			}
+		}
+		counts[best]++
+	}
+	for improved := true; improved; {
+		improved = false
+		for i := range counts {
+			for j := range counts {
+				// Moving one atom from isotope i to j scales the probability by this ratio.
+				if i != j && counts[i] > 0 && float64(counts[i])*p[j] > float64(counts[j]+1)*p[i] {
+					counts[i]--
+					counts[j]++
+					improved = true
+				}
+			}
+		}
+	}
+	return counts
+}
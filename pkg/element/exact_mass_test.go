@@ -0,0 +1,62 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestExactMasses(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula              string
+		expectedMonoisotopic string
+		expectedNominal      int64
+		expectedMostAbundant string
+	}{
+		{formula: "H2O", expectedMonoisotopic: "18.01056468403", expectedNominal: 18, expectedMostAbundant: "18.01056468403"},
+		{formula: "CH2Cl2", expectedMonoisotopic: "83.95335542846", expectedNominal: 84, expectedMostAbundant: "83.95335542846"},
+		{formula: "Br2", expectedMonoisotopic: "157.8366752", expectedNominal: 158, expectedMostAbundant: "159.8346273"},
+		{formula: "C100", expectedMonoisotopic: "1200", expectedNominal: 1200, expectedMostAbundant: "1201.00335483507"},
+		{formula: "[13C]O2", expectedMonoisotopic: "44.99318407421", expectedNominal: 45, expectedMostAbundant: "44.99318407421"},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			compound, err := NewCompound(test.formula, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			mono, err := compound.MonoisotopicMass()
+			if err != nil || !mono.Equal(decimal.RequireFromString(test.expectedMonoisotopic)) {
+				t.Errorf("Expected monoisotopic mass %s, but got %v (%v)", test.expectedMonoisotopic, mono, err)
+			}
+			nominal, err := compound.NominalMass()
+			if err != nil || !nominal.Equal(decimal.NewFromInt(test.expectedNominal)) {
+				t.Errorf("Expected nominal mass %d, but got %v (%v)", test.expectedNominal, nominal, err)
+			}
+			mostAbundant, err := compound.MostAbundantMass()
+			if err != nil || !mostAbundant.Equal(decimal.RequireFromString(test.expectedMostAbundant)) {
+				t.Errorf("Expected most abundant mass %s, but got %v (%v)", test.expectedMostAbundant, mostAbundant, err)
+			}
+		})
+	}
+}
+
+func TestExactMassErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	technetium, _ := NewCompound("TcO4", pt)
+	if _, err := technetium.MonoisotopicMass(); err == nil {
+		t.Errorf("Expected an error for an element with no natural isotopes")
+	}
+	if _, err := technetium.MostAbundantMass(); err == nil {
+		t.Errorf("Expected an error for an element with no natural isotopes")
+	}
+	noData, _ := NewCompound("H2O", NewTestPeriodicTable())
+	if _, err := noData.NominalMass(); err == nil {
+		t.Errorf("Expected an error for elements without isotope data")
+	}
+	fractional := Compound{Elements: []ElementMoles{{Element: Element{Symbol: "H", Isotopes: isotopeData[1]}, Moles: decimal.NewFromFloat(0.5)}}}
+	if _, err := fractional.MonoisotopicMass(); err == nil {
+		t.Errorf("Expected an error for a fractional atom count")
+	}
+}
@@ -0,0 +1,352 @@
+package element
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// closers maps every opening bracket the parser accepts to its closing partner.
+var closers = map[byte]byte{'(': ')', '[': ']', '{': '}'}
+
+// hydrateSeparators join the parts of a hydrate or adduct, as in CuSO4·5H2O or CaCl2*2H2O.
+var hydrateSeparators = []string{"·", "•", "⋅", "*", "."}
+
+// hydrogenIsotopes are the shorthand symbols for deuterium and tritium.
+var hydrogenIsotopes = map[string]int{"D": 2, "T": 3}
+
+// atomKey identifies an element, or one of its isotopes when massNumber is set.
+type atomKey struct {
+	symbol     string
+	massNumber int
+}
+
+// atomCounts keeps element counts in the order the atoms first appear in a formula.
+type atomCounts struct {
+	keys   []atomKey
+	counts map[atomKey]int64
+}
+
+func newAtomCounts() *atomCounts {
+	return &atomCounts{counts: make(map[atomKey]int64)}
+}
+
+// add reports false, leaving the counts unchanged, when the total would overflow an int64.
+func (ac *atomCounts) add(key atomKey, count int64) bool {
+	if count > math.MaxInt64-ac.counts[key] {
+		return false
+	}
+	if _, seen := ac.counts[key]; !seen {
+		ac.keys = append(ac.keys, key)
+	}
+	ac.counts[key] += count
+	return true
+}
+
+// merge adds every count in other, multiplied by the group multiplier.
+// It reports false when a product or total would overflow an int64.
+func (ac *atomCounts) merge(other *atomCounts, multiplier int64) bool {
+	for _, key := range other.keys {
+		count := other.counts[key]
+		if count > math.MaxInt64/multiplier || !ac.add(key, count*multiplier) {
+			return false
+		}
+	}
+	return true
+}
+
+func (ac *atomCounts) toElementMoles(pt *PeriodicTable) []ElementMoles {
+	elements := make([]ElementMoles, 0, len(ac.keys))
+	for _, key := range ac.keys {
+		element, _ := pt.FindElementBySymbol(key.symbol) // symbols are checked while parsing
+		em := ElementMoles{Element: *element, Moles: decimal.NewFromInt(ac.counts[key])}
+		if key.massNumber > 0 {
+			em.Isotope, _ = element.FindIsotope(key.massNumber)
+		}
+		elements = append(elements, em)
+	}
+	return elements
+}
+
+// formulaSegment is one dot-separated part of a formula with its leading coefficient.
+type formulaSegment struct {
+	text        string
+	coefficient int64
+	counts      *atomCounts
+}
+
+// isWater reports whether the segment is exactly H2O.
+func (fs formulaSegment) isWater() bool {
+	return len(fs.counts.keys) == 2 && fs.counts.counts[atomKey{symbol: "H"}] == 2 && fs.counts.counts[atomKey{symbol: "O"}] == 1
+}
+
+// formulaParser is a recursive-descent parser for chemical formulas:
+//
+//	compound := formula (separator coefficient? formula)*
+//	formula  := unit+
+//	unit     := (element | label | group) count?
+//	label    := "[" digit+ element "]" | "^" digit+ element
+//	group    := "(" formula ")" | "[" formula "]" | "{" formula "}"
+//	element  := upper lower? | "D" | "T"
+//	count    := digit+
+type formulaParser struct {
+	input string
+	pos   int
+	pt    *PeriodicTable
+}
+
+func (p *formulaParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+// fail builds a FormulaError for the token at offset.
+func (p *formulaParser) fail(offset int, expected string, suggestion string) *FormulaError {
+	return &FormulaError{Formula: p.input, Offset: offset, Token: runeAt(p.input, offset), Expected: expected, Suggestion: suggestion}
+}
+
+func (p *formulaParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// separatorLen returns the byte length of the hydrate separator at the current position, or 0.
+func (p *formulaParser) separatorLen() int {
+	for _, sep := range hydrateSeparators {
+		if strings.HasPrefix(p.input[p.pos:], sep) {
+			return len(sep)
+		}
+	}
+	return 0
+}
+
+// parseCompound reads the whole input as separator-joined segments.
+// Only segments after a separator may carry a leading coefficient.
+func (p *formulaParser) parseCompound() ([]formulaSegment, error) {
+	var segments []formulaSegment
+	coefficient := int64(1)
+	coefficientStart, coefficientEnd := 0, 0
+	// total only checks that the whole compound's counts fit in an int64.
+	total := newAtomCounts()
+	for {
+		start := p.pos
+		counts, err := p.parseFormula(0)
+		if err != nil {
+			return nil, err
+		}
+		if !total.merge(counts, coefficient) {
+			return nil, p.overflow(coefficientStart, coefficientEnd)
+		}
+		segments = append(segments, formulaSegment{text: p.input[start:p.pos], coefficient: coefficient, counts: counts})
+		if p.done() {
+			return segments, nil
+		}
+		sepLen := p.separatorLen()
+		if sepLen == 0 {
+			return nil, p.fail(p.pos, "separator or end of formula", "")
+		}
+		p.pos += sepLen
+		coefficientStart = p.pos
+		if coefficient, err = p.parseCount(); err != nil {
+			return nil, err
+		}
+		coefficientEnd = p.pos
+	}
+}
+
+// parseFormula reads units until the input ends, a separator is reached or the closing bracket is reached.
+// A closing of 0 means the formula is at the top level.
+func (p *formulaParser) parseFormula(closing byte) (*atomCounts, error) {
+	counts := newAtomCounts()
+	for !p.done() && p.peek() != closing && p.separatorLen() == 0 {
+		if c := p.peek(); isCloser(c) {
+			if closing == 0 {
+				return nil, p.fail(p.pos, "element symbol, group or end of formula", fmt.Sprintf("remove the unmatched %c", c))
+			}
+			return nil, p.fail(p.pos, fmt.Sprintf("%q", closing), fmt.Sprintf("replace %c with %c", c, closing))
+		}
+		if err := p.parseUnit(counts); err != nil {
+			return nil, err
+		}
+	}
+	if len(counts.keys) == 0 {
+		return nil, p.fail(p.pos, "element symbol or group", "")
+	}
+	return counts, nil
+}
+
+func (p *formulaParser) parseUnit(counts *atomCounts) error {
+	c := p.peek()
+	switch {
+	case isUpper(c), p.atLabel():
+		var key atomKey
+		var err error
+		if isUpper(c) {
+			key, err = p.parseElement()
+		} else {
+			key, err = p.parseLabel()
+		}
+		if err != nil {
+			return err
+		}
+		countStart := p.pos
+		count, err := p.parseCount()
+		if err != nil {
+			return err
+		}
+		if !counts.add(key, count) {
+			return p.overflow(countStart, p.pos)
+		}
+	case closers[c] != 0:
+		group, err := p.parseGroup()
+		if err != nil {
+			return err
+		}
+		countStart := p.pos
+		count, err := p.parseCount()
+		if err != nil {
+			return err
+		}
+		if !counts.merge(group, count) {
+			return p.overflow(countStart, p.pos)
+		}
+	case isLower(c):
+		end := p.pos + 1
+		if end < len(p.input) && isLower(p.input[end]) {
+			end++
+		}
+		err := p.fail(p.pos, "element symbol", suggestSymbols(p.input[p.pos:end], p.pt))
+		err.Token = p.input[p.pos:end]
+		return err
+	case isDigit(c) && p.pos == 0:
+		count, _ := p.parseCount()
+		return p.fail(0, "element symbol or group", fmt.Sprintf("remove the leading coefficient %d", count))
+	default:
+		return p.fail(p.pos, "element symbol or group", "")
+	}
+	return nil
+}
+
+func (p *formulaParser) parseElement() (atomKey, error) {
+	start := p.pos
+	p.pos++
+	if isLower(p.peek()) {
+		p.pos++
+	}
+	symbol := p.input[start:p.pos]
+	if _, found := p.pt.FindElementBySymbol(symbol); found {
+		return atomKey{symbol: symbol}, nil
+	}
+	if massNumber, ok := hydrogenIsotopes[symbol]; ok {
+		return p.isotope(start, "H", massNumber)
+	}
+	err := p.fail(start, "element symbol", suggestSymbols(symbol, p.pt))
+	err.Token = symbol
+	return atomKey{}, err
+}
+
+// atLabel reports whether an isotope label such as [13C] or ^18O starts here.
+func (p *formulaParser) atLabel() bool {
+	c := p.peek()
+	return (c == '[' || c == '^') && p.pos+1 < len(p.input) && isDigit(p.input[p.pos+1])
+}
+
+func (p *formulaParser) parseLabel() (atomKey, error) {
+	start := p.pos
+	open := p.peek()
+	p.pos++
+	digits := p.pos
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	massNumber, err := strconv.Atoi(p.input[digits:p.pos])
+	if err != nil {
+		return atomKey{}, p.fail(digits, "mass number", "")
+	}
+	if !isUpper(p.peek()) {
+		return atomKey{}, p.fail(p.pos, "element symbol", "")
+	}
+	key, err := p.parseElement()
+	if err != nil {
+		return atomKey{}, err
+	}
+	if key.massNumber > 0 {
+		return atomKey{}, p.fail(p.pos-1, "element symbol", "D and T already carry a mass number")
+	}
+	if open == '[' {
+		if p.peek() != ']' {
+			return atomKey{}, p.fail(p.pos, `']'`, fmt.Sprintf("close the [ opened at offset %d", start))
+		}
+		p.pos++
+	}
+	return p.isotope(start, key.symbol, massNumber)
+}
+
+// isotope checks that the labelled isotope is in the element's isotope data.
+func (p *formulaParser) isotope(start int, symbol string, massNumber int) (atomKey, error) {
+	element, found := p.pt.FindElementBySymbol(symbol)
+	if !found {
+		return atomKey{}, p.fail(start, "element symbol", "")
+	}
+	if _, found := element.FindIsotope(massNumber); !found {
+		var known []string
+		for _, isotope := range element.Isotopes {
+			known = append(known, fmt.Sprintf("%d%s", isotope.MassNumber, symbol))
+		}
+		err := p.fail(start, "known isotope", didYouMean(known))
+		err.Token = p.input[start:p.pos]
+		return atomKey{}, err
+	}
+	return atomKey{symbol: symbol, massNumber: massNumber}, nil
+}
+
+func (p *formulaParser) parseGroup() (*atomCounts, error) {
+	open := p.peek()
+	start := p.pos
+	p.pos++
+	group, err := p.parseFormula(closers[open])
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != closers[open] {
+		return nil, p.fail(p.pos, fmt.Sprintf("%q", closers[open]), fmt.Sprintf("close the %c opened at offset %d", open, start))
+	}
+	p.pos++
+	return group, nil
+}
+
+// parseCount reads an optional multiplier, defaulting to 1.
+func (p *formulaParser) parseCount() (int64, error) {
+	start := p.pos
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	if start == p.pos {
+		return 1, nil
+	}
+	count, err := strconv.ParseInt(p.input[start:p.pos], 10, 64)
+	if err != nil || count == 0 {
+		fail := p.fail(start, "count greater than 0", "")
+		fail.Token = p.input[start:p.pos]
+		return 0, fail
+	}
+	return count, nil
+}
+
+// overflow reports a count between start and end that makes an atom total too large to hold.
+func (p *formulaParser) overflow(start, end int) *FormulaError {
+	fail := p.fail(start, "smaller count", fmt.Sprintf("atom totals cannot exceed %d", int64(math.MaxInt64)))
+	if end > start {
+		fail.Token = p.input[start:end]
+	}
+	return fail
+}
+
+func isUpper(c byte) bool  { return c >= 'A' && c <= 'Z' }
+func isLower(c byte) bool  { return c >= 'a' && c <= 'z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isCloser(c byte) bool { return c == ')' || c == ']' || c == '}' }
@@ -0,0 +1,78 @@
+package element
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// FormulaError describes where and why a formula could not be parsed.
+type FormulaError struct {
+	Formula    string // the formula being parsed
+	Offset     int    // byte offset of the offending token
+	Token      string // the offending token, empty at the end of the formula
+	Expected   string // what the parser was looking for
+	Suggestion string // a possible fix, may be empty
+}
+
+func (e *FormulaError) Error() string {
+	found := "end of formula"
+	if e.Token != "" {
+		found = fmt.Sprintf("%q", e.Token)
+	}
+	msg := fmt.Sprintf("invalid formula %q at offset %d: found %s, expected %s", e.Formula, e.Offset, found, e.Expected)
+	if e.Suggestion != "" {
+		msg += " (" + e.Suggestion + ")"
+	}
+	return msg
+}
+
+// runeAt returns the character starting at offset as a string, or "" past the end.
+func runeAt(s string, offset int) string {
+	if offset >= len(s) {
+		return ""
+	}
+	_, size := utf8.DecodeRuneInString(s[offset:])
+	return s[offset : offset+size]
+}
+
+// suggestSymbols offers element symbols a mistyped token may have meant:
+// its capitalized form, each letter as its own element, or symbols sharing the first letter.
+func suggestSymbols(token string, pt *PeriodicTable) string {
+	if token == "" {
+		return ""
+	}
+	var candidates []string
+	capitalized := strings.ToUpper(token[:1]) + strings.ToLower(token[1:])
+	if _, found := pt.FindElementBySymbol(capitalized); found && capitalized != token {
+		candidates = append(candidates, capitalized)
+	}
+	if len(token) == 2 {
+		first, second := strings.ToUpper(token[:1]), strings.ToUpper(token[1:])
+		_, firstFound := pt.FindElementBySymbol(first)
+		_, secondFound := pt.FindElementBySymbol(second)
+		if firstFound && secondFound && first+second != token {
+			candidates = append(candidates, first+second)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, element := range pt.Elements {
+			if strings.EqualFold(element.Symbol[:1], token[:1]) && element.Symbol != token {
+				candidates = append(candidates, element.Symbol)
+			}
+		}
+	}
+	return didYouMean(candidates)
+}
+
+func didYouMean(candidates []string) string {
+	switch len(candidates) {
+	case 0:
+		return ""
+	case 1:
+		return "did you mean " + candidates[0] + "?"
+	default:
+		last := len(candidates) - 1
+		return "did you mean " + strings.Join(candidates[:last], ", ") + " or " + candidates[last] + "?"
+	}
+}
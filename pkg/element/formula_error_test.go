@@ -0,0 +1,82 @@
+package element
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormulaErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula            string
+		expectedOffset     int
+		expectedToken      string
+		expectedExpected   string
+		expectedSuggestion string
+	}{
+		{formula: "H2O1X", expectedOffset: 4, expectedToken: "X", expectedExpected: "element symbol", expectedSuggestion: "did you mean Xe?"},
+		{formula: "h2o", expectedOffset: 0, expectedToken: "h", expectedExpected: "element symbol", expectedSuggestion: "did you mean H?"},
+		{formula: "co", expectedOffset: 0, expectedToken: "co", expectedExpected: "element symbol", expectedSuggestion: "did you mean Co or CO?"},
+		{formula: "H2O)", expectedOffset: 3, expectedToken: ")", expectedExpected: "element symbol, group or end of formula", expectedSuggestion: "remove the unmatched )"},
+		{formula: "2H2O", expectedOffset: 0, expectedToken: "2", expectedExpected: "element symbol or group", expectedSuggestion: "remove the leading coefficient 2"},
+		{formula: "Ca(OH", expectedOffset: 5, expectedToken: "", expectedExpected: `')'`, expectedSuggestion: "close the ( opened at offset 2"},
+		{formula: "Ca(OH]2", expectedOffset: 5, expectedToken: "]", expectedExpected: `')'`, expectedSuggestion: "replace ] with )"},
+		{formula: "H 2O", expectedOffset: 1, expectedToken: " ", expectedExpected: "element symbol or group"},
+		{formula: "H0", expectedOffset: 1, expectedToken: "0", expectedExpected: "count greater than 0"},
+		{formula: "CuSO4·", expectedOffset: 7, expectedToken: "", expectedExpected: "element symbol or group"},
+		{formula: "NaCl→", expectedOffset: 4, expectedToken: "→", expectedExpected: "element symbol or group"},
+		{formula: "", expectedOffset: 0, expectedToken: "", expectedExpected: "element symbol or group"},
+		{formula: "(H999999999999)99999999999", expectedOffset: 15, expectedToken: "99999999999", expectedExpected: "smaller count", expectedSuggestion: "atom totals cannot exceed 9223372036854775807"},
+		{formula: "H9223372036854775807H2", expectedOffset: 21, expectedToken: "2", expectedExpected: "smaller count", expectedSuggestion: "atom totals cannot exceed 9223372036854775807"},
+		{formula: "H9223372036854775807·2H2O", expectedOffset: 22, expectedToken: "2", expectedExpected: "smaller count", expectedSuggestion: "atom totals cannot exceed 9223372036854775807"},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			_, err := ParseCompoundElements(test.formula, pt)
+			var formulaErr *FormulaError
+			if !errors.As(err, &formulaErr) {
+				t.Fatalf("Expected a FormulaError, but got %v", err)
+			}
+			if formulaErr.Offset != test.expectedOffset {
+				t.Errorf("Expected offset %d, but got %d", test.expectedOffset, formulaErr.Offset)
+			}
+			if formulaErr.Token != test.expectedToken {
+				t.Errorf("Expected token %q, but got %q", test.expectedToken, formulaErr.Token)
+			}
+			if formulaErr.Expected != test.expectedExpected {
+				t.Errorf("Expected %q to be expected, but got %q", test.expectedExpected, formulaErr.Expected)
+			}
+			if formulaErr.Suggestion != test.expectedSuggestion {
+				t.Errorf("Expected suggestion %q, but got %q", test.expectedSuggestion, formulaErr.Suggestion)
+			}
+		})
+	}
+}
+
+func TestSpeciesFormulaErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		symbol         string
+		expectedOffset int
+		expectedToken  string
+	}{
+		{symbol: "Na0+", expectedOffset: 2, expectedToken: "0+"},
+		{symbol: "SO4^0-", expectedOffset: 3, expectedToken: "^0-"},
+		{symbol: "Zz3+", expectedOffset: 0, expectedToken: "Zz"},
+	}
+	for _, test := range tests {
+		t.Run(test.symbol, func(t *testing.T) {
+			_, err := NewSpecies(test.symbol, pt)
+			var formulaErr *FormulaError
+			if !errors.As(err, &formulaErr) {
+				t.Fatalf("Expected a FormulaError, but got %v", err)
+			}
+			if formulaErr.Formula != test.symbol {
+				t.Errorf("Expected formula %q, but got %q", test.symbol, formulaErr.Formula)
+			}
+			if formulaErr.Offset != test.expectedOffset || formulaErr.Token != test.expectedToken {
+				t.Errorf("Expected %q at offset %d, but got %q at %d", test.expectedToken, test.expectedOffset, formulaErr.Token, formulaErr.Offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseNestedFormulas(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula       string
+		expected      map[string]int64
+		expectedError bool
+	}{
+		{formula: "Ca(OH)2", expected: map[string]int64{"Ca": 1, "O": 2, "H": 2}},
+		{formula: "Al2(SO4)3", expected: map[string]int64{"Al": 2, "S": 3, "O": 12}},
+		{formula: "K4[Fe(CN)6]", expected: map[string]int64{"K": 4, "Fe": 1, "C": 6, "N": 6}},
+		{formula: "{[(CH3)2]2N}2", expected: map[string]int64{"C": 8, "H": 24, "N": 2}},
+		{formula: "(NH4)3PO4", expected: map[string]int64{"N": 3, "H": 12, "P": 1, "O": 4}},
+		{formula: "Ca(OH", expectedError: true},
+		{formula: "Ca(OH]2", expectedError: true},
+		{formula: "CaOH)2", expectedError: true},
+		{formula: "Ca()2", expectedError: true},
+		{formula: "H0", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			result, err := ParseCompoundElements(test.formula, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if len(result) != len(test.expected) {
+				t.Errorf("Expected %d elements, but got %d", len(test.expected), len(result))
+			}
+			for _, em := range result {
+				if !em.Moles.Equal(decimal.NewFromInt(test.expected[em.Element.Symbol])) {
+					t.Errorf("Expected %d %s, but got %v", test.expected[em.Element.Symbol], em.Element.Symbol, em.Moles)
+				}
+			}
+		})
+	}
+}
+
+func TestParseKeepsFirstAppearanceOrder(t *testing.T) {
+	result, err := ParseCompoundElements("CH3COOH", NewPeriodicTable())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"C", "H", "O"}
+	for i, em := range result {
+		if em.Element.Symbol != expected[i] {
+			t.Errorf("Expected %s at index %d, but got %s", expected[i], i, em.Element.Symbol)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// GasConstant is R in J/(mol·K), exact since the Boltzmann and Avogadro constants were fixed in 2019.
+var GasConstant = decimal.RequireFromString("8.31446261815324")
+
+// GasConstantIn expresses R in the given pressure and volume units per mole per kelvin,
+// such as 0.082057… L·atm/(mol·K) or 8.314… L·kPa/(mol·K).
+func GasConstantIn(pressureUnit PressureUnit, pressurePrefix Prefix, volumeUnit VolumeUnit, volumePrefix Prefix) (decimal.Decimal, error) {
+	pressure, err := pressureUnitPowers(pressureUnit, pressurePrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	volume, err := volumeUnitPowers(volumeUnit, volumePrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return gasConstantIn(pressure, volume)
+}
+
+func gasConstantIn(pressure, volume []unitPower) (decimal.Decimal, error) {
+	perMoleKelvin, err := parseUnits("1/(mol·K)")
+	if err != nil {
+		return decimal.Zero, err
+	}
+	r, err := NewQuantity(GasConstant, "J/(mol·K)")
+	if err != nil {
+		return decimal.Zero, err
+	}
+	conversion, err := r.convertTo(multiplyUnits(multiplyUnits(pressure, volume), perMoleKelvin))
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return conversion.Result.value, nil
+}
+
+// gasConstantFor is R in the units of the pressure and volume given.
+func gasConstantFor(p Pressure, v Volume) (decimal.Decimal, error) {
+	pressure, err := pressureUnitPowers(p.unit, p.prefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	volume, err := volumeUnitPowers(v.unit, v.prefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return gasConstantIn(pressure, volume)
+}
+
+func kelvin(t Temperature) (decimal.Decimal, error) {
+	k, err := t.convertToStandard()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !k.IsPositive() {
+		return decimal.Zero, fmt.Errorf("an ideal gas needs a temperature above absolute zero")
+	}
+	return k, nil
+}
+
+// IdealGasPressure solves P = nRT/V. The pressure is in atmospheres unless a PressureUnit
+// or Prefix is passed, as with NewPressure; R is matched to those units and the volume's.
+func IdealGasPressure(v Volume, moles decimal.Decimal, t Temperature, options ...interface{}) (Pressure, error) {
+	if !moles.IsPositive() {
+		return Pressure{}, fmt.Errorf("moles of gas must be positive, got %v", moles)
+	}
+	result, err := NewPressure(decimal.NewFromInt(1), options...)
+	if err != nil {
+		return Pressure{}, err
+	}
+	r, err := gasConstantFor(result, v)
+	if err != nil {
+		return Pressure{}, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return Pressure{}, err
+	}
+	if !v.value.IsPositive() {
+		return Pressure{}, fmt.Errorf("volume must be positive, got %v", v.value)
+	}
+	result.value = quotient(moles.Mul(r).Mul(k), v.value)
+	return result, nil
+}
+
+// IdealGasVolume solves V = nRT/P. The volume is in liters unless a VolumeUnit or Prefix
+// is passed, as with NewVolume; R is matched to those units and the pressure's.
+func IdealGasVolume(p Pressure, moles decimal.Decimal, t Temperature, options ...interface{}) (Volume, error) {
+	if !moles.IsPositive() {
+		return Volume{}, fmt.Errorf("moles of gas must be positive, got %v", moles)
+	}
+	result, err := NewVolume(decimal.NewFromInt(1), options...)
+	if err != nil {
+		return Volume{}, err
+	}
+	r, err := gasConstantFor(p, result)
+	if err != nil {
+		return Volume{}, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return Volume{}, err
+	}
+	if !p.value.IsPositive() {
+		return Volume{}, fmt.Errorf("pressure must be positive, got %v", p.value)
+	}
+	result.value = quotient(moles.Mul(r).Mul(k), p.value)
+	return result, nil
+}
+
+// IdealGasMoles solves n = PV/RT, with R matched to the units of the pressure and volume.
+func IdealGasMoles(p Pressure, v Volume, t Temperature) (decimal.Decimal, error) {
+	if !p.value.IsPositive() {
+		return decimal.Zero, fmt.Errorf("pressure must be positive, got %v", p.value)
+	}
+	if !v.value.IsPositive() {
+		return decimal.Zero, fmt.Errorf("volume must be positive, got %v", v.value)
+	}
+	r, err := gasConstantFor(p, v)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quotient(p.value.Mul(v.value), r.Mul(k)), nil
+}
+
+// IdealGasTemperature solves T = PV/nR and reads the result on the given scale.
+func IdealGasTemperature(p Pressure, v Volume, moles decimal.Decimal, scale TemperatureScale) (Temperature, error) {
+	if !moles.IsPositive() {
+		return Temperature{}, fmt.Errorf("moles of gas must be positive, got %v", moles)
+	}
+	if !p.value.IsPositive() {
+		return Temperature{}, fmt.Errorf("pressure must be positive, got %v", p.value)
+	}
+	if !v.value.IsPositive() {
+		return Temperature{}, fmt.Errorf("volume must be positive, got %v", v.value)
+	}
+	r, err := gasConstantFor(p, v)
+	if err != nil {
+		return Temperature{}, err
+	}
+	k, err := NewTemperature(quotient(p.value.Mul(v.value), moles.Mul(r)), Kelvin)
+	if err != nil {
+		return Temperature{}, err
+	}
+	return k.Convert(scale)
+}
+
+// GasMolarMass finds the molar mass in g/mol of a gas sample from its mass, pressure, volume and temperature.
+func GasMolarMass(m Mass, p Pressure, v Volume, t Temperature) (decimal.Decimal, error) {
+	grams, err := m.convertToStandard()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	moles, err := IdealGasMoles(p, v, t)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quotient(grams, moles), nil
+}
+
+// GasDensity is the density in g/L of the compound as an ideal gas, d = PM/RT.
+func (compound *Compound) GasDensity(p Pressure, t Temperature) (decimal.Decimal, error) {
+	if compound.MolarMass.Equal(decimal.Zero) {
+		if err := compound.getMolarMass(); err != nil {
+			return decimal.Zero, err
+		}
+	}
+	return gasDensity(compound.MolarMass, p, t)
+}
+
+func gasDensity(molarMass decimal.Decimal, p Pressure, t Temperature) (decimal.Decimal, error) {
+	atmospheres, err := p.convertToStandard()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	r, err := GasConstantIn(Atmosphere, NoPrefix, Liter, NoPrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return quotient(atmospheres.Mul(molarMass), r.Mul(k)), nil
+}
+
+func (compound *Compound) getMolesFromGas(p Pressure, v Volume, t Temperature) error {
+	moles, err := IdealGasMoles(p, v, t)
+	if err != nil {
+		return err
+	}
+	compound.Moles = moles
+	return nil
+}
@@ -0,0 +1,215 @@
+package element
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// GasMixture is a set of ideal gases sharing a container, each Compound holding its own Moles.
+type GasMixture struct {
+	Components []Compound
+}
+
+// NewGasMixture combines compounds into a mixture. Every compound needs a positive number of
+// moles, and compounds with the same symbol are merged.
+func NewGasMixture(components ...Compound) (GasMixture, error) {
+	if len(components) == 0 {
+		return GasMixture{}, fmt.Errorf("a gas mixture needs at least one compound")
+	}
+	var mixture GasMixture
+	index := make(map[string]int)
+	for _, compound := range components {
+		if !compound.Moles.IsPositive() {
+			return GasMixture{}, fmt.Errorf("moles of %s must be positive, got %v", compound.Symbol, compound.Moles)
+		}
+		if compound.MolarMass.Equal(decimal.Zero) {
+			if err := compound.getMolarMass(); err != nil {
+				return GasMixture{}, fmt.Errorf("%s: %v", compound.Symbol, err)
+			}
+		}
+		if i, found := index[compound.Symbol]; found {
+			mixture.Components[i].Moles = mixture.Components[i].Moles.Add(compound.Moles)
+			continue
+		}
+		index[compound.Symbol] = len(mixture.Components)
+		mixture.Components = append(mixture.Components, compound)
+	}
+	return mixture, nil
+}
+
+// TotalMoles is the moles of every gas in the mixture added together.
+func (m GasMixture) TotalMoles() decimal.Decimal {
+	total := decimal.Zero
+	for _, compound := range m.Components {
+		total = total.Add(compound.Moles)
+	}
+	return total
+}
+
+// positiveTotal is TotalMoles, checked so the mixture can be divided by it. Components is
+// exported, so a mixture built without NewGasMixture may be empty or hold negative moles.
+func (m GasMixture) positiveTotal() (decimal.Decimal, error) {
+	for _, compound := range m.Components {
+		if compound.Moles.IsNegative() {
+			return decimal.Zero, fmt.Errorf("moles of %s must not be negative, got %v", compound.Symbol, compound.Moles)
+		}
+	}
+	total := m.TotalMoles()
+	if !total.IsPositive() {
+		return decimal.Zero, fmt.Errorf("the gas mixture has no moles of gas")
+	}
+	return total, nil
+}
+
+// MoleFraction is the share of the mixture's moles that belong to the compound with the given symbol.
+func (m GasMixture) MoleFraction(symbol string) (decimal.Decimal, error) {
+	total, err := m.positiveTotal()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	for _, compound := range m.Components {
+		if compound.Symbol == symbol {
+			return quotient(compound.Moles, total), nil
+		}
+	}
+	return decimal.Zero, fmt.Errorf("%s is not in the mixture", symbol)
+}
+
+// MoleFractions maps each compound's symbol to its mole fraction.
+func (m GasMixture) MoleFractions() (map[string]decimal.Decimal, error) {
+	total, err := m.positiveTotal()
+	if err != nil {
+		return nil, err
+	}
+	fractions := make(map[string]decimal.Decimal, len(m.Components))
+	for _, compound := range m.Components {
+		fractions[compound.Symbol] = fractions[compound.Symbol].Add(quotient(compound.Moles, total))
+	}
+	return fractions, nil
+}
+
+// PartialPressures splits a total pressure by mole fraction, Pᵢ = xᵢP, keeping the total's unit.
+func (m GasMixture) PartialPressures(total Pressure) (map[string]Pressure, error) {
+	fractions, err := m.MoleFractions()
+	if err != nil {
+		return nil, err
+	}
+	partials := make(map[string]Pressure, len(fractions))
+	for symbol, fraction := range fractions {
+		partials[symbol] = Pressure{value: total.value.Mul(fraction), unit: total.unit, prefix: total.prefix}
+	}
+	return partials, nil
+}
+
+// TotalPressure is the pressure of the whole mixture in a volume, taking the same options as IdealGasPressure.
+func (m GasMixture) TotalPressure(v Volume, t Temperature, options ...interface{}) (Pressure, error) {
+	return IdealGasPressure(v, m.TotalMoles(), t, options...)
+}
+
+// AverageMolarMass is the mole-fraction weighted molar mass in g/mol.
+func (m GasMixture) AverageMolarMass() (decimal.Decimal, error) {
+	total, err := m.positiveTotal()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	mass := decimal.Zero
+	for _, compound := range m.Components {
+		if compound.MolarMass.Equal(decimal.Zero) {
+			if err := compound.getMolarMass(); err != nil {
+				return decimal.Zero, fmt.Errorf("%s: %v", compound.Symbol, err)
+			}
+		}
+		mass = mass.Add(compound.Moles.Mul(compound.MolarMass))
+	}
+	return quotient(mass, total), nil
+}
+
+// Density is the density in g/L of the mixture as an ideal gas, using its average molar mass.
+func (m GasMixture) Density(p Pressure, t Temperature) (decimal.Decimal, error) {
+	molarMass, err := m.AverageMolarMass()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return gasDensity(molarMass, p, t)
+}
+
+// waterVapor is the vapor pressure of water in torr at a temperature in °C.
+type waterVapor struct {
+	celsius int64
+	torr    string
+}
+
+var waterVaporTable = []waterVapor{
+	{0, "4.58"}, {5, "6.54"}, {10, "9.21"}, {15, "12.79"}, {16, "13.63"}, {17, "14.53"},
+	{18, "15.48"}, {19, "16.48"}, {20, "17.54"}, {21, "18.65"}, {22, "19.83"}, {23, "21.07"},
+	{24, "22.38"}, {25, "23.76"}, {26, "25.21"}, {27, "26.74"}, {28, "28.35"}, {29, "30.04"},
+	{30, "31.82"}, {35, "42.18"}, {40, "55.32"}, {45, "71.88"}, {50, "92.51"}, {55, "118.04"},
+	{60, "149.38"}, {65, "187.54"}, {70, "233.7"}, {75, "289.1"}, {80, "355.1"}, {85, "433.6"},
+	{90, "525.8"}, {95, "633.9"}, {100, "760.0"},
+}
+
+// WaterVaporPressure looks up the vapor pressure of water in torr, interpolating linearly
+// between table entries from 0 °C to 100 °C.
+func WaterVaporPressure(t Temperature) (Pressure, error) {
+	c, err := t.Convert(Celsius)
+	if err != nil {
+		return Pressure{}, err
+	}
+	celsius := c.value
+	first, last := waterVaporTable[0], waterVaporTable[len(waterVaporTable)-1]
+	if celsius.LessThan(decimal.NewFromInt(first.celsius)) || celsius.GreaterThan(decimal.NewFromInt(last.celsius)) {
+		return Pressure{}, fmt.Errorf("no water vapor pressure for %v °C, the table covers %d °C to %d °C", celsius, first.celsius, last.celsius)
+	}
+	// The first entry above the temperature, or the last entry at exactly 100 °C.
+	i := sort.Search(len(waterVaporTable), func(i int) bool {
+		return decimal.NewFromInt(waterVaporTable[i].celsius).GreaterThan(celsius)
+	})
+	if i == len(waterVaporTable) {
+		i--
+	}
+	low, high := waterVaporTable[i-1], waterVaporTable[i]
+	lowTorr, highTorr := decimal.RequireFromString(low.torr), decimal.RequireFromString(high.torr)
+	span := decimal.NewFromInt(high.celsius - low.celsius)
+	torr := lowTorr.Add(highTorr.Sub(lowTorr).Mul(quotient(celsius.Sub(decimal.NewFromInt(low.celsius)), span)))
+	return Pressure{value: torr, unit: Torr, prefix: NoPrefix}, nil
+}
+
+// DryGasPressure takes the water vapor out of the pressure of a gas collected over water,
+// giving the pressure of the gas alone in the same unit as the total.
+func DryGasPressure(total Pressure, t Temperature) (Pressure, error) {
+	vapor, err := WaterVaporPressure(t)
+	if err != nil {
+		return Pressure{}, err
+	}
+	vapor, err = vapor.Convert(total.unit, total.prefix)
+	if err != nil {
+		return Pressure{}, err
+	}
+	if vapor.value.GreaterThanOrEqual(total.value) {
+		return Pressure{}, fmt.Errorf("a total pressure of %v %s is not above the water vapor pressure of %v %s", total.value, total.unit, vapor.value.Round(4), total.unit)
+	}
+	return Pressure{value: total.value.Sub(vapor.value), unit: total.unit, prefix: total.prefix}, nil
+}
+
+// CollectOverWater finds the moles of a gas collected over water from the total pressure,
+// volume and temperature, and returns it mixed with the water vapor it was collected with.
+func CollectOverWater(compound Compound, total Pressure, v Volume, t Temperature, pt *PeriodicTable) (GasMixture, error) {
+	dry, err := DryGasPressure(total, t)
+	if err != nil {
+		return GasMixture{}, err
+	}
+	if err := compound.getMolesFromGas(dry, v, t); err != nil {
+		return GasMixture{}, err
+	}
+	water, err := NewCompound("H2O", pt)
+	if err != nil {
+		return GasMixture{}, err
+	}
+	vapor := Pressure{value: total.value.Sub(dry.value), unit: total.unit, prefix: total.prefix}
+	if err := water.getMolesFromGas(vapor, v, t); err != nil {
+		return GasMixture{}, err
+	}
+	return NewGasMixture(compound, water)
+}
@@ -0,0 +1,174 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func gasWithMoles(t *testing.T, pt *PeriodicTable, symbol, moles string) Compound {
+	compound, err := NewCompound(symbol, pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	compound.Moles = decimal.RequireFromString(moles)
+	return compound
+}
+
+func TestGasMixture(t *testing.T) {
+	pt := NewPeriodicTable()
+	n2 := gasWithMoles(t, pt, "N2", "1")
+	h2 := gasWithMoles(t, pt, "H2", "2")
+	mixture, err := NewGasMixture(n2, h2, gasWithMoles(t, pt, "H2", "1"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mixture.Components) != 2 || !mixture.TotalMoles().Equal(decimal.NewFromInt(4)) {
+		t.Fatalf("Expected H2 to be merged into 4 mol of 2 gases, but got %v", mixture.Components)
+	}
+
+	fractions, err := mixture.MoleFractions()
+	if err != nil || !fractions["N2"].Equal(decimal.RequireFromString("0.25")) || !fractions["H2"].Equal(decimal.RequireFromString("0.75")) {
+		t.Errorf("Expected mole fractions of 0.25 and 0.75, but got %v (%v)", fractions, err)
+	}
+	if _, err := mixture.MoleFraction("O2"); err == nil {
+		t.Errorf("Expected an error for a gas that is not in the mixture")
+	}
+
+	total, _ := NewPressure(decimal.NewFromInt(4), Pascal, Kilo)
+	partials, err := mixture.PartialPressures(total)
+	if p := partials["H2"]; err != nil || !p.Value().Equal(decimal.NewFromInt(3)) || p.unit != Pascal || p.prefix != Kilo {
+		t.Errorf("Expected 3 kPa of H2, but got %v (%v)", p, err)
+	}
+
+	average := n2.MolarMass.Add(h2.MolarMass.Mul(decimal.NewFromInt(3))).Div(decimal.NewFromInt(4))
+	if molarMass, err := mixture.AverageMolarMass(); err != nil || !molarMass.Round(10).Equal(average.Round(10)) {
+		t.Errorf("Expected an average molar mass of %v, but got %v (%v)", average, molarMass, err)
+	}
+
+	atm, _ := NewPressure(decimal.NewFromInt(1))
+	freezing, _ := NewTemperature(decimal.Zero, Celsius)
+	density, err := mixture.Density(atm, freezing)
+	expected := average.Div(decimal.RequireFromString("22.413969545014"))
+	if err != nil || !density.Round(8).Equal(expected.Round(8)) {
+		t.Errorf("Expected a density of %v g/L, but got %v (%v)", expected, density, err)
+	}
+
+	molarVolume, _ := NewVolume(decimal.RequireFromString("22.413969545014"))
+	pressure, err := mixture.TotalPressure(molarVolume, freezing)
+	if err != nil || !pressure.Value().Round(8).Equal(decimal.NewFromInt(4)) {
+		t.Errorf("Expected 4 atm, but got %v (%v)", pressure.Value(), err)
+	}
+}
+
+func TestNewGasMixtureErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		name       string
+		components []Compound
+	}{
+		{name: "no compounds"},
+		{name: "no moles", components: []Compound{gasWithMoles(t, pt, "O2", "0")}},
+		{name: "no elements", components: []Compound{{Symbol: "XX", Moles: decimal.NewFromInt(1)}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewGasMixture(test.components...); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestGasMixtureWithoutMoles(t *testing.T) {
+	pt := NewPeriodicTable()
+	atm, _ := NewPressure(decimal.NewFromInt(1))
+	room, _ := NewTemperature(decimal.NewFromInt(25), Celsius)
+	tests := []struct {
+		name    string
+		mixture GasMixture
+	}{
+		{name: "empty", mixture: GasMixture{}},
+		{name: "zero moles", mixture: GasMixture{Components: []Compound{gasWithMoles(t, pt, "N2", "0"), gasWithMoles(t, pt, "O2", "0")}}},
+		{name: "negative moles", mixture: GasMixture{Components: []Compound{gasWithMoles(t, pt, "N2", "2"), gasWithMoles(t, pt, "O2", "-1")}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.mixture.MoleFraction("N2"); err == nil {
+				t.Errorf("Expected an error from MoleFraction")
+			}
+			if _, err := test.mixture.MoleFractions(); err == nil {
+				t.Errorf("Expected an error from MoleFractions")
+			}
+			if _, err := test.mixture.PartialPressures(atm); err == nil {
+				t.Errorf("Expected an error from PartialPressures")
+			}
+			if _, err := test.mixture.AverageMolarMass(); err == nil {
+				t.Errorf("Expected an error from AverageMolarMass")
+			}
+			if _, err := test.mixture.Density(atm, room); err == nil {
+				t.Errorf("Expected an error from Density")
+			}
+		})
+	}
+}
+
+func TestWaterVaporPressure(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		scale         TemperatureScale
+		expected      string
+		expectedError bool
+	}{
+		{name: "table entry", value: "25", scale: Celsius, expected: "23.76"},
+		{name: "between entries", value: "22.5", scale: Celsius, expected: "20.45"},
+		{name: "wide step", value: "32", scale: Celsius, expected: "35.964"},
+		{name: "freezing", value: "273.15", scale: Kelvin, expected: "4.58"},
+		{name: "boiling", value: "212", scale: Fahrenheit, expected: "760"},
+		{name: "too hot", value: "105", scale: Celsius, expectedError: true},
+		{name: "too cold", value: "-5", scale: Celsius, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			temperature, _ := NewTemperature(decimal.RequireFromString(test.value), test.scale)
+			vapor, err := WaterVaporPressure(temperature)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if !test.expectedError && (!vapor.Value().Round(6).Equal(decimal.RequireFromString(test.expected)) || vapor.unit != Torr) {
+				t.Errorf("Expected %s torr, but got %v %s", test.expected, vapor.Value(), vapor.unit)
+			}
+		})
+	}
+}
+
+func TestCollectOverWater(t *testing.T) {
+	pt := NewPeriodicTable()
+	o2, _ := NewCompound("O2", pt)
+	total, _ := NewPressure(decimal.NewFromInt(755), Torr)
+	room, _ := NewTemperature(decimal.NewFromInt(25), Celsius)
+	volume, _ := NewVolume(decimal.NewFromInt(250), Milli)
+
+	dry, err := DryGasPressure(total, room)
+	if err != nil || !dry.Value().Equal(decimal.RequireFromString("731.24")) {
+		t.Errorf("Expected 731.24 torr of dry gas, but got %v (%v)", dry.Value(), err)
+	}
+
+	mixture, err := CollectOverWater(o2, total, volume, room, pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fraction, _ := mixture.MoleFraction("O2")
+	if !fraction.Round(10).Equal(decimal.RequireFromString("731.24").Div(decimal.NewFromInt(755)).Round(10)) {
+		t.Errorf("Expected the O2 mole fraction to be its share of the pressure, but got %v", fraction)
+	}
+	if moles := mixture.Components[0].Moles; !moles.Round(6).Equal(decimal.RequireFromString("0.009832")) {
+		t.Errorf("Expected 0.009832 mol O2, but got %v", moles)
+	}
+
+	low, _ := NewPressure(decimal.NewFromInt(20), Torr)
+	if _, err := DryGasPressure(low, room); err == nil {
+		t.Errorf("Expected an error for a total below the vapor pressure")
+	}
+}
@@ -0,0 +1,134 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGasConstantIn(t *testing.T) {
+	tests := []struct {
+		name           string
+		pressureUnit   PressureUnit
+		pressurePrefix Prefix
+		volumeUnit     VolumeUnit
+		volumePrefix   Prefix
+		expected       string
+		expectedError  bool
+	}{
+		{name: "L·atm", pressureUnit: Atmosphere, pressurePrefix: NoPrefix, volumeUnit: Liter, volumePrefix: NoPrefix, expected: "0.0820573661"},
+		{name: "L·kPa", pressureUnit: Pascal, pressurePrefix: Kilo, volumeUnit: Liter, volumePrefix: NoPrefix, expected: "8.3144626182"},
+		{name: "m³·Pa", pressureUnit: Pascal, pressurePrefix: NoPrefix, volumeUnit: CubicMeter, volumePrefix: NoPrefix, expected: "8.3144626182"},
+		{name: "L·torr", pressureUnit: Torr, pressurePrefix: NoPrefix, volumeUnit: Liter, volumePrefix: NoPrefix, expected: "62.3635982215"},
+		{name: "mL·bar", pressureUnit: Bar, pressurePrefix: NoPrefix, volumeUnit: Liter, volumePrefix: Milli, expected: "83.1446261815"},
+		{name: "prefixed torr", pressureUnit: Torr, pressurePrefix: Kilo, volumeUnit: Liter, volumePrefix: NoPrefix, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := GasConstantIn(test.pressureUnit, test.pressurePrefix, test.volumeUnit, test.volumePrefix)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if !test.expectedError && !r.Round(10).Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s, but got %v", test.expected, r)
+			}
+		})
+	}
+}
+
+func TestIdealGasSolvers(t *testing.T) {
+	atm, _ := NewPressure(decimal.NewFromInt(1))
+	kPa, _ := NewPressure(decimal.RequireFromString("101.325"), Pascal, Kilo)
+	molarVolume, _ := NewVolume(decimal.RequireFromString("22.413969545014"))
+	freezing, _ := NewTemperature(decimal.Zero, Celsius)
+	one := decimal.NewFromInt(1)
+
+	moles, err := IdealGasMoles(atm, molarVolume, freezing)
+	if err != nil || !moles.Round(10).Equal(one) {
+		t.Errorf("Expected 1 mol, but got %v (%v)", moles, err)
+	}
+	moles, err = IdealGasMoles(kPa, molarVolume, freezing)
+	if err != nil || !moles.Round(10).Equal(one) {
+		t.Errorf("Expected 1 mol from kPa, but got %v (%v)", moles, err)
+	}
+
+	pressure, err := IdealGasPressure(molarVolume, one, freezing, Pascal, Kilo)
+	if err != nil || !pressure.Value().Round(8).Equal(decimal.RequireFromString("101.325")) || pressure.unit != Pascal {
+		t.Errorf("Expected 101.325 kPa, but got %v (%v)", pressure.Value(), err)
+	}
+
+	volume, err := IdealGasVolume(atm, decimal.NewFromInt(2), freezing, Milli)
+	if err != nil || !volume.Value().Round(6).Equal(decimal.RequireFromString("44827.93909")) || volume.prefix != Milli {
+		t.Errorf("Expected 44827.93909 mL, but got %v (%v)", volume.Value(), err)
+	}
+
+	temperature, err := IdealGasTemperature(atm, molarVolume, one, Celsius)
+	if err != nil || !temperature.Value().Round(8).Equal(decimal.Zero) {
+		t.Errorf("Expected 0 °C, but got %v (%v)", temperature.Value(), err)
+	}
+
+	zero, _ := NewTemperature(decimal.Zero, Kelvin)
+	if _, err := IdealGasMoles(atm, molarVolume, zero); err == nil {
+		t.Errorf("Expected an error at absolute zero")
+	}
+	if _, err := IdealGasPressure(molarVolume, decimal.Zero, freezing); err == nil {
+		t.Errorf("Expected an error for zero moles")
+	}
+
+	negativePressure := Pressure{value: decimal.NewFromInt(-1), unit: Atmosphere, prefix: NoPrefix}
+	emptyVolume := Volume{value: decimal.Zero, unit: Liter, prefix: NoPrefix}
+	tests := []struct {
+		name  string
+		solve func() error
+	}{
+		{name: "moles from a negative pressure", solve: func() error { _, err := IdealGasMoles(negativePressure, molarVolume, freezing); return err }},
+		{name: "moles from an empty volume", solve: func() error { _, err := IdealGasMoles(atm, emptyVolume, freezing); return err }},
+		{name: "temperature from a negative pressure", solve: func() error {
+			_, err := IdealGasTemperature(negativePressure, molarVolume, one, Kelvin)
+			return err
+		}},
+		{name: "temperature from an empty volume", solve: func() error { _, err := IdealGasTemperature(atm, emptyVolume, one, Kelvin); return err }},
+		{name: "molar mass from an empty volume", solve: func() error {
+			mass, _ := NewMass(decimal.NewFromInt(1))
+			_, err := GasMolarMass(mass, atm, emptyVolume, freezing)
+			return err
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.solve(); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestGasMolarMassAndDensity(t *testing.T) {
+	pt := NewPeriodicTable()
+	atm, _ := NewPressure(decimal.NewFromInt(1))
+	room, _ := NewTemperature(decimal.NewFromInt(25), Celsius)
+	freezing, _ := NewTemperature(decimal.Zero, Celsius)
+	half, _ := NewVolume(decimal.RequireFromString("0.5"))
+	sample, _ := NewMass(decimal.NewFromInt(1))
+
+	molarMass, err := GasMolarMass(sample, atm, half, room)
+	if err != nil || !molarMass.Round(2).Equal(decimal.RequireFromString("48.93")) {
+		t.Errorf("Expected 48.93 g/mol, but got %v (%v)", molarMass, err)
+	}
+
+	co2, err := NewCompound("CO2", pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	density, err := co2.GasDensity(atm, freezing)
+	if err != nil || !density.Round(4).Equal(decimal.RequireFromString("1.9635")) {
+		t.Errorf("Expected 1.9635 g/L, but got %v (%v)", density, err)
+	}
+
+	if err := co2.getMolesFromGas(atm, half, freezing); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !co2.Moles.Round(6).Equal(decimal.RequireFromString("0.022308")) {
+		t.Errorf("Expected 0.022308 mol, but got %v", co2.Moles)
+	}
+}
@@ -0,0 +1,114 @@
+package element
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Hydrate separates the water of crystallization from the rest of a hydrate or adduct formula.
+type Hydrate struct {
+	Symbol         string
+	Anhydrous      Compound        // every segment that isn't water of hydration
+	Water          decimal.Decimal // H2O per formula unit
+	waterMolarMass decimal.Decimal
+}
+
+// ParseHydrate splits formulas such as "CuSO4·5H2O", "CaCl2*2H2O" or "Na2CO3.10H2O".
+// Water only counts as hydration when it follows a separator; other adducts stay in Anhydrous.
+func ParseHydrate(formula string, pt *PeriodicTable) (Hydrate, error) {
+	segments, err := parseSegments(formula, pt)
+	if err != nil {
+		return Hydrate{}, err
+	}
+	anhydrous := newAtomCounts()
+	var parts []string
+	var water int64
+	for i, segment := range segments {
+		if i > 0 && segment.isWater() {
+			water += segment.coefficient
+			continue
+		}
+		anhydrous.merge(segment.counts, segment.coefficient)
+		parts = append(parts, segmentSymbol(segment.coefficient, segment.text))
+	}
+	hydrate := Hydrate{
+		Symbol:    formula,
+		Anhydrous: Compound{Symbol: strings.Join(parts, "·"), Elements: anhydrous.toElementMoles(pt)},
+		Water:     decimal.NewFromInt(water),
+	}
+	if err := hydrate.Anhydrous.getMolarMass(); err != nil {
+		return Hydrate{}, err
+	}
+	if water > 0 {
+		if hydrate.waterMolarMass, err = waterMolarMass(pt); err != nil {
+			return Hydrate{}, err
+		}
+	}
+	return hydrate, nil
+}
+
+// MolarMass is the molar mass of the whole hydrate, water included.
+func (h Hydrate) MolarMass() decimal.Decimal {
+	return h.Anhydrous.MolarMass.Add(h.Water.Mul(h.waterMolarMass))
+}
+
+// PercentWater is the mass percent of water of hydration.
+func (h Hydrate) PercentWater() (decimal.Decimal, error) {
+	total := h.MolarMass()
+	if total.Equal(decimal.Zero) {
+		return decimal.Zero, fmt.Errorf("hydrate has no molar mass")
+	}
+	return h.Water.Mul(h.waterMolarMass).Div(total).Mul(decimal.NewFromInt(100)), nil
+}
+
+// HydrateFromMassLoss finds x in anhydrous·xH2O from the mass before and after heating off the water.
+// x is rounded to the nearest whole number.
+func HydrateFromMassLoss(anhydrous string, hydratedMass Mass, anhydrousMass Mass, pt *PeriodicTable) (Hydrate, error) {
+	salt, err := NewCompound(anhydrous, pt)
+	if err != nil {
+		return Hydrate{}, err
+	}
+	waterMM, err := waterMolarMass(pt)
+	if err != nil {
+		return Hydrate{}, err
+	}
+	before, err := hydratedMass.convertToStandard()
+	if err != nil {
+		return Hydrate{}, err
+	}
+	after, err := anhydrousMass.convertToStandard()
+	if err != nil {
+		return Hydrate{}, err
+	}
+	if after.GreaterThan(before) {
+		return Hydrate{}, fmt.Errorf("anhydrous mass %v g is greater than hydrated mass %v g", after, before)
+	}
+	saltMoles, err := anhydrousMass.getMoles(salt.MolarMass)
+	if err != nil {
+		return Hydrate{}, err
+	}
+	waterMoles := before.Sub(after).Div(waterMM)
+	water := waterMoles.Div(saltMoles).Round(0)
+	hydrate := Hydrate{Symbol: salt.Symbol, Anhydrous: salt, Water: water, waterMolarMass: waterMM}
+	if water.IsPositive() {
+		hydrate.Symbol += "·" + segmentSymbol(water.IntPart(), "H2O")
+	}
+	return hydrate, nil
+}
+
+func waterMolarMass(pt *PeriodicTable) (decimal.Decimal, error) {
+	water, err := NewCompound("H2O", pt)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return water.MolarMass, nil
+}
+
+func segmentSymbol(coefficient int64, symbol string) string {
+	if coefficient == 1 {
+		return symbol
+	}
+	return fmt.Sprintf("%d%s", coefficient, symbol)
+}
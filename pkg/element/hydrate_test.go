@@ -0,0 +1,128 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseHydrate(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula           string
+		expectedAnhydrous string
+		expectedWater     int64
+		expectedMolarMass string
+		expectedError     bool
+	}{
+		{formula: "CuSO4·5H2O", expectedAnhydrous: "CuSO4", expectedWater: 5, expectedMolarMass: "249.682"},
+		{formula: "CaCl2*2H2O", expectedAnhydrous: "CaCl2", expectedWater: 2, expectedMolarMass: "147.008"},
+		{formula: "Na2CO3.10H2O", expectedAnhydrous: "Na2CO3", expectedWater: 10, expectedMolarMass: "286.13753856"},
+		{formula: "AlCl3·NH3·H2O", expectedAnhydrous: "AlCl3·NH3", expectedWater: 1, expectedMolarMass: "168.3775385"},
+		{formula: "H2O", expectedAnhydrous: "H2O", expectedWater: 0, expectedMolarMass: "18.015"},
+		{formula: "CuSO4·0H2O", expectedError: true},
+		{formula: "CuSO4·", expectedError: true},
+		{formula: "CuSO4··5H2O", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			hydrate, err := ParseHydrate(test.formula, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if hydrate.Anhydrous.Symbol != test.expectedAnhydrous {
+				t.Errorf("Expected anhydrous part %s, but got %s", test.expectedAnhydrous, hydrate.Anhydrous.Symbol)
+			}
+			if !hydrate.Water.Equal(decimal.NewFromInt(test.expectedWater)) {
+				t.Errorf("Expected %d waters, but got %v", test.expectedWater, hydrate.Water)
+			}
+			if !hydrate.MolarMass().Equal(decimal.RequireFromString(test.expectedMolarMass)) {
+				t.Errorf("Expected molar mass %s, but got %v", test.expectedMolarMass, hydrate.MolarMass())
+			}
+		})
+	}
+}
+
+func TestHydrateMolarMassMatchesCompound(t *testing.T) {
+	pt := NewPeriodicTable()
+	hydrate, _ := ParseHydrate("CuSO4·5H2O", pt)
+	compound, err := NewCompound("CuSO4·5H2O", pt)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !compound.MolarMass.Equal(hydrate.MolarMass()) {
+		t.Errorf("Expected molar mass %v, but got %v", hydrate.MolarMass(), compound.MolarMass)
+	}
+}
+
+func TestPercentWater(t *testing.T) {
+	hydrate, err := ParseHydrate("CuSO4·5H2O", NewPeriodicTable())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	percent, err := hydrate.PercentWater()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := decimal.RequireFromString("36.08")
+	if !percent.Round(2).Equal(expected) {
+		t.Errorf("Expected %v percent water, but got %v", expected, percent)
+	}
+}
+
+func TestHydrateFromMassLoss(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		name          string
+		anhydrous     string
+		hydrated      Mass
+		dried         Mass
+		expected      string
+		expectedWater int64
+		expectedError bool
+	}{
+		{
+			name:          "copper sulfate pentahydrate",
+			anhydrous:     "CuSO4",
+			hydrated:      Mass{value: decimal.NewFromFloat(2.50), unit: Gram, prefix: NoPrefix},
+			dried:         Mass{value: decimal.NewFromFloat(1.598), unit: Gram, prefix: NoPrefix},
+			expected:      "CuSO4·5H2O",
+			expectedWater: 5,
+		},
+		{
+			name:          "barium chloride dihydrate in milligrams",
+			anhydrous:     "BaCl2",
+			hydrated:      Mass{value: decimal.NewFromFloat(488.5), unit: Gram, prefix: Milli},
+			dried:         Mass{value: decimal.NewFromFloat(416.5), unit: Gram, prefix: Milli},
+			expected:      "BaCl2·2H2O",
+			expectedWater: 2,
+		},
+		{
+			name:          "dried mass heavier than hydrate",
+			anhydrous:     "CuSO4",
+			hydrated:      Mass{value: decimal.NewFromFloat(1), unit: Gram, prefix: NoPrefix},
+			dried:         Mass{value: decimal.NewFromFloat(2), unit: Gram, prefix: NoPrefix},
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hydrate, err := HydrateFromMassLoss(test.anhydrous, test.hydrated, test.dried, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if hydrate.Symbol != test.expected {
+				t.Errorf("Expected %s, but got %s", test.expected, hydrate.Symbol)
+			}
+			if !hydrate.Water.Equal(decimal.NewFromInt(test.expectedWater)) {
+				t.Errorf("Expected %d waters, but got %v", test.expectedWater, hydrate.Water)
+			}
+		})
+	}
+}
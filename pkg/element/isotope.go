@@ -0,0 +1,61 @@
+package element
+
+import "github.com/shopspring/decimal"
+
+// Half-lives are kept in seconds.
+const (
+	minute = 60.0
+	hour   = 60 * minute
+	day    = 24 * hour
+	year   = 365.25 * day
+)
+
+// Isotope is a single nuclide of an element.
+type Isotope struct {
+	MassNumber int             // Protons plus neutrons
+	ExactMass  decimal.Decimal // Atomic mass in u (g/mol)
+	Abundance  decimal.Decimal // Natural abundance as a fraction, zero for synthetic nuclides
+	Stable     bool            // Whether the nuclide is observationally stable
+	HalfLife   float64         // Half-life in seconds, zero when stable
+}
+
+func stable(massNumber int, exactMass string, abundance string) Isotope {
+	return Isotope{MassNumber: massNumber, ExactMass: decimal.RequireFromString(exactMass), Abundance: decimal.RequireFromString(abundance), Stable: true}
+}
+
+func radioactive(massNumber int, exactMass string, abundance string, halfLife float64) Isotope {
+	return Isotope{MassNumber: massNumber, ExactMass: decimal.RequireFromString(exactMass), Abundance: decimal.RequireFromString(abundance), HalfLife: halfLife}
+}
+
+// FindIsotope looks up one of the element's isotopes by mass number.
+func (e Element) FindIsotope(massNumber int) (*Isotope, bool) {
+	for _, isotope := range e.Isotopes {
+		if isotope.MassNumber == massNumber {
+			return &isotope, true
+		}
+	}
+	return nil, false
+}
+
+// MostAbundantIsotope returns the isotope with the highest natural abundance.
+// Elements with no natural isotopes, such as technetium, have none.
+func (e Element) MostAbundantIsotope() (*Isotope, bool) {
+	var best *Isotope
+	for i, isotope := range e.Isotopes {
+		if isotope.Abundance.IsPositive() && (best == nil || isotope.Abundance.GreaterThan(best.Abundance)) {
+			best = &e.Isotopes[i]
+		}
+	}
+	return best, best != nil
+}
+
+// naturalIsotopes returns the isotopes that occur in nature.
+func (e Element) naturalIsotopes() []Isotope {
+	var natural []Isotope
+	for _, isotope := range e.Isotopes {
+		if isotope.Abundance.IsPositive() {
+			natural = append(natural, isotope)
+		}
+	}
+	return natural
+}
@@ -0,0 +1,612 @@
+package element
+
+// isotopeData lists, by atomic number, the naturally occurring isotopes of each element
+// plus the most commonly used radioisotopes. Masses are atomic masses in u and abundances
+// are mole fractions, following NIST and IUPAC tables. Elements without stable isotopes
+// list their longest-lived or most studied nuclides; masses beyond nobelium are estimates.
+var isotopeData = map[int][]Isotope{
+	1: {
+		stable(1, "1.00782503223", "0.999885"),
+		stable(2, "2.01410177812", "0.000115"),
+		radioactive(3, "3.0160492779", "0", 12.32*year),
+	},
+	2: {
+		stable(3, "3.0160293201", "0.00000134"),
+		stable(4, "4.00260325413", "0.99999866"),
+	},
+	3: {
+		stable(6, "6.0151228874", "0.0759"),
+		stable(7, "7.0160034366", "0.9241"),
+	},
+	4: {
+		stable(9, "9.012183065", "1"),
+	},
+	5: {
+		stable(10, "10.01293695", "0.199"),
+		stable(11, "11.00930536", "0.801"),
+	},
+	6: {
+		stable(12, "12", "0.9893"),
+		stable(13, "13.00335483507", "0.0107"),
+		radioactive(14, "14.0032419884", "0", 5730*year),
+	},
+	7: {
+		stable(14, "14.00307400443", "0.99636"),
+		stable(15, "15.00010889888", "0.00364"),
+	},
+	8: {
+		stable(16, "15.99491461957", "0.99757"),
+		stable(17, "16.99913175650", "0.00038"),
+		stable(18, "17.99915961286", "0.00205"),
+	},
+	9: {
+		stable(19, "18.99840316273", "1"),
+		radioactive(18, "18.0009373", "0", 109.77*minute),
+	},
+	10: {
+		stable(20, "19.9924401762", "0.9048"),
+		stable(21, "20.993846685", "0.0027"),
+		stable(22, "21.991385114", "0.0925"),
+	},
+	11: {
+		stable(23, "22.9897692820", "1"),
+		radioactive(22, "21.9944364", "0", 2.6018*year),
+		radioactive(24, "23.99096295", "0", 14.956*hour),
+	},
+	12: {
+		stable(24, "23.985041697", "0.7899"),
+		stable(25, "24.985836976", "0.1000"),
+		stable(26, "25.982592968", "0.1101"),
+	},
+	13: {
+		stable(27, "26.98153853", "1"),
+	},
+	14: {
+		stable(28, "27.97692653465", "0.92223"),
+		stable(29, "28.97649466490", "0.04685"),
+		stable(30, "29.973770136", "0.03092"),
+	},
+	15: {
+		stable(31, "30.97376199842", "1"),
+		radioactive(32, "31.97390764", "0", 14.268*day),
+		radioactive(33, "32.9717257", "0", 25.35*day),
+	},
+	16: {
+		stable(32, "31.9720711744", "0.9499"),
+		stable(33, "32.9714589098", "0.0075"),
+		stable(34, "33.967867004", "0.0425"),
+		stable(36, "35.96708071", "0.0001"),
+		radioactive(35, "34.96903231", "0", 87.37*day),
+	},
+	17: {
+		stable(35, "34.968852682", "0.7576"),
+		stable(37, "36.965902602", "0.2424"),
+		radioactive(36, "35.968306809", "0", 3.01e5*year),
+	},
+	18: {
+		stable(36, "35.967545105", "0.003336"),
+		stable(38, "37.96273211", "0.000629"),
+		stable(40, "39.9623831237", "0.996035"),
+	},
+	19: {
+		stable(39, "38.9637064864", "0.932581"),
+		radioactive(40, "39.963998166", "0.000117", 1.248e9*year),
+		stable(41, "40.9618252579", "0.067302"),
+	},
+	20: {
+		stable(40, "39.962590863", "0.96941"),
+		stable(42, "41.95861783", "0.00647"),
+		stable(43, "42.95876644", "0.00135"),
+		stable(44, "43.95548156", "0.02086"),
+		stable(46, "45.9536890", "0.00004"),
+		stable(48, "47.95252276", "0.00187"),
+		radioactive(45, "44.95618635", "0", 162.61*day),
+	},
+	21: {
+		stable(45, "44.95590828", "1"),
+	},
+	22: {
+		stable(46, "45.95262772", "0.0825"),
+		stable(47, "46.95175879", "0.0744"),
+		stable(48, "47.94794198", "0.7372"),
+		stable(49, "48.94786568", "0.0541"),
+		stable(50, "49.94478689", "0.0518"),
+	},
+	23: {
+		radioactive(50, "49.94715601", "0.00250", 2.65e17*year),
+		stable(51, "50.94395704", "0.99750"),
+	},
+	24: {
+		stable(50, "49.94604183", "0.04345"),
+		stable(52, "51.94050623", "0.83789"),
+		stable(53, "52.94064815", "0.09501"),
+		stable(54, "53.93887916", "0.02365"),
+		radioactive(51, "50.94476502", "0", 27.7025*day),
+	},
+	25: {
+		stable(55, "54.93804391", "1"),
+		radioactive(54, "53.9403576", "0", 312.2*day),
+	},
+	26: {
+		stable(54, "53.93960899", "0.05845"),
+		stable(56, "55.93493633", "0.91754"),
+		stable(57, "56.93539284", "0.02119"),
+		stable(58, "57.93327443", "0.00282"),
+		radioactive(59, "58.93487434", "0", 44.495*day),
+	},
+	27: {
+		stable(59, "58.93319429", "1"),
+		radioactive(57, "56.93629057", "0", 271.74*day),
+		radioactive(60, "59.93381630", "0", 5.2714*year),
+	},
+	28: {
+		stable(58, "57.93534241", "0.68077"),
+		stable(60, "59.93078588", "0.26223"),
+		stable(61, "60.93105557", "0.011399"),
+		stable(62, "61.92834537", "0.036346"),
+		stable(64, "63.92796682", "0.009255"),
+	},
+	29: {
+		stable(63, "62.92959772", "0.6915"),
+		stable(65, "64.92778970", "0.3085"),
+		radioactive(64, "63.92976434", "0", 12.701*hour),
+	},
+	30: {
+		stable(64, "63.92914201", "0.4917"),
+		stable(66, "65.92603381", "0.2773"),
+		stable(67, "66.92712775", "0.0404"),
+		stable(68, "67.92484455", "0.1845"),
+		stable(70, "69.9253192", "0.0061"),
+	},
+	31: {
+		stable(69, "68.9255735", "0.60108"),
+		stable(71, "70.92470258", "0.39892"),
+		radioactive(67, "66.9282025", "0", 3.2617*day),
+	},
+	32: {
+		stable(70, "69.92424875", "0.2057"),
+		stable(72, "71.922075826", "0.2745"),
+		stable(73, "72.923458956", "0.0775"),
+		stable(74, "73.921177761", "0.3650"),
+		stable(76, "75.921402726", "0.0773"),
+	},
+	33: {
+		stable(75, "74.92159457", "1"),
+	},
+	34: {
+		stable(74, "73.922475934", "0.0089"),
+		stable(76, "75.919213704", "0.0937"),
+		stable(77, "76.919914154", "0.0763"),
+		stable(78, "77.91730928", "0.2377"),
+		stable(80, "79.9165218", "0.4961"),
+		stable(82, "81.9166995", "0.0873"),
+	},
+	35: {
+		stable(79, "78.9183376", "0.5069"),
+		stable(81, "80.9162897", "0.4931"),
+	},
+	36: {
+		stable(78, "77.92036494", "0.00355"),
+		stable(80, "79.91637808", "0.02286"),
+		stable(82, "81.91348273", "0.11593"),
+		stable(83, "82.91412716", "0.11500"),
+		stable(84, "83.9114977282", "0.56987"),
+		stable(86, "85.9106106269", "0.17279"),
+		radioactive(85, "84.9125273", "0", 10.739*year),
+	},
+	37: {
+		stable(85, "84.9117897379", "0.7217"),
+		radioactive(87, "86.9091805310", "0.2783", 4.97e10*year),
+	},
+	38: {
+		stable(84, "83.9134191", "0.0056"),
+		stable(86, "85.9092606", "0.0986"),
+		stable(87, "86.9088775", "0.0700"),
+		stable(88, "87.9056125", "0.8258"),
+		radioactive(90, "89.9077300", "0", 28.79*year),
+	},
+	39: {
+		stable(89, "88.9058403", "1"),
+		radioactive(90, "89.9071439", "0", 64.053*hour),
+	},
+	40: {
+		stable(90, "89.9046977", "0.5145"),
+		stable(91, "90.9056396", "0.1122"),
+		stable(92, "91.9050347", "0.1715"),
+		stable(94, "93.9063108", "0.1738"),
+		stable(96, "95.9082714", "0.0280"),
+	},
+	41: {
+		stable(93, "92.9063730", "1"),
+	},
+	42: {
+		stable(92, "91.90680796", "0.1453"),
+		stable(94, "93.90508490", "0.0915"),
+		stable(95, "94.90583877", "0.1584"),
+		stable(96, "95.90467612", "0.1667"),
+		stable(97, "96.90601812", "0.0960"),
+		stable(98, "97.90540482", "0.2439"),
+		stable(100, "99.9074718", "0.0982"),
+		radioactive(99, "98.9077085", "0", 65.976*hour),
+	},
+	43: {
+		radioactive(97, "96.9063667", "0", 4.21e6*year),
+		radioactive(98, "97.9072124", "0", 4.2e6*year),
+		radioactive(99, "98.9062508", "0", 2.111e5*year),
+	},
+	44: {
+		stable(96, "95.90759025", "0.0554"),
+		stable(98, "97.9052868", "0.0187"),
+		stable(99, "98.9059341", "0.1276"),
+		stable(100, "99.9042143", "0.1260"),
+		stable(101, "100.9055769", "0.1706"),
+		stable(102, "101.9043441", "0.3155"),
+		stable(104, "103.9054275", "0.1862"),
+	},
+	45: {
+		stable(103, "102.9054980", "1"),
+	},
+	46: {
+		stable(102, "101.9056022", "0.0102"),
+		stable(104, "103.9040305", "0.1114"),
+		stable(105, "104.9050796", "0.2233"),
+		stable(106, "105.9034804", "0.2733"),
+		stable(108, "107.9038916", "0.2646"),
+		stable(110, "109.9051722", "0.1172"),
+	},
+	47: {
+		stable(107, "106.9050916", "0.51839"),
+		stable(109, "108.9047553", "0.48161"),
+		radioactive(110, "109.9061102", "0", 249.83*day),
+	},
+	48: {
+		stable(106, "105.9064599", "0.0125"),
+		stable(108, "107.9041834", "0.0089"),
+		stable(110, "109.90300661", "0.1249"),
+		stable(111, "110.90418287", "0.1280"),
+		stable(112, "111.90276287", "0.2413"),
+		stable(113, "112.90440813", "0.1222"),
+		stable(114, "113.90336509", "0.2873"),
+		stable(116, "115.90476315", "0.0749"),
+	},
+	49: {
+		stable(113, "112.90406184", "0.0429"),
+		radioactive(115, "114.903878776", "0.9571", 4.41e14*year),
+		radioactive(111, "110.9051085", "0", 2.8047*day),
+	},
+	50: {
+		stable(112, "111.90482387", "0.0097"),
+		stable(114, "113.9027827", "0.0066"),
+		stable(115, "114.903344699", "0.0034"),
+		stable(116, "115.90174280", "0.1454"),
+		stable(117, "116.90295398", "0.0768"),
+		stable(118, "117.90160657", "0.2422"),
+		stable(119, "118.90331117", "0.0859"),
+		stable(120, "119.90220163", "0.3258"),
+		stable(122, "121.9034438", "0.0463"),
+		stable(124, "123.9052766", "0.0579"),
+	},
+	51: {
+		stable(121, "120.9038120", "0.5721"),
+		stable(123, "122.9042132", "0.4279"),
+	},
+	52: {
+		stable(120, "119.9040593", "0.0009"),
+		stable(122, "121.9030435", "0.0255"),
+		stable(123, "122.9042698", "0.0089"),
+		stable(124, "123.9028171", "0.0474"),
+		stable(125, "124.9044299", "0.0707"),
+		stable(126, "125.9033109", "0.1884"),
+		radioactive(128, "127.90446128", "0.3174", 2.2e24*year),
+		radioactive(130, "129.906222748", "0.3408", 7.9e20*year),
+	},
+	53: {
+		stable(127, "126.9044719", "1"),
+		radioactive(123, "122.9055898", "0", 13.2235*hour),
+		radioactive(125, "124.9046294", "0", 59.49*day),
+		radioactive(131, "130.9061263", "0", 8.0252*day),
+	},
+	54: {
+		stable(124, "123.9058920", "0.000952"),
+		stable(126, "125.9042983", "0.000890"),
+		stable(128, "127.9035310", "0.019102"),
+		stable(129, "128.9047808611", "0.264006"),
+		stable(130, "129.903509349", "0.040710"),
+		stable(131, "130.90508406", "0.212324"),
+		stable(132, "131.9041550856", "0.269086"),
+		stable(134, "133.90539466", "0.104357"),
+		stable(136, "135.907214484", "0.088573"),
+	},
+	55: {
+		stable(133, "132.9054519610", "1"),
+		radioactive(137, "136.9070895", "0", 30.08*year),
+	},
+	56: {
+		stable(130, "129.9063207", "0.00106"),
+		stable(132, "131.9050611", "0.00101"),
+		stable(134, "133.90450818", "0.02417"),
+		stable(135, "134.90568838", "0.06592"),
+		stable(136, "135.90457573", "0.07854"),
+		stable(137, "136.90582714", "0.11232"),
+		stable(138, "137.90524700", "0.71698"),
+	},
+	57: {
+		radioactive(138, "137.9071149", "0.0008881", 1.02e11*year),
+		stable(139, "138.9063563", "0.9991119"),
+	},
+	58: {
+		stable(136, "135.90712921", "0.00185"),
+		stable(138, "137.905991", "0.00251"),
+		stable(140, "139.9054431", "0.88450"),
+		stable(142, "141.9092504", "0.11114"),
+	},
+	59: {
+		stable(141, "140.9076576", "1"),
+	},
+	60: {
+		stable(142, "141.9077290", "0.27152"),
+		stable(143, "142.9098200", "0.12174"),
+		radioactive(144, "143.9100930", "0.23798", 2.29e15*year),
+		stable(145, "144.9125793", "0.08293"),
+		stable(146, "145.9131226", "0.17189"),
+		stable(148, "147.9168993", "0.05756"),
+		stable(150, "149.9209022", "0.05638"),
+	},
+	61: {
+		radioactive(145, "144.9127559", "0", 17.7*year),
+		radioactive(147, "146.9151450", "0", 2.6234*year),
+	},
+	62: {
+		stable(144, "143.9120065", "0.0307"),
+		radioactive(147, "146.9149044", "0.1499", 1.06e11*year),
+		radioactive(148, "147.9148292", "0.1124", 7e15*year),
+		stable(149, "148.9171921", "0.1382"),
+		stable(150, "149.9172829", "0.0738"),
+		stable(152, "151.9197397", "0.2675"),
+		stable(154, "153.9222169", "0.2275"),
+	},
+	63: {
+		radioactive(151, "150.9198578", "0.4781", 5e18*year),
+		stable(153, "152.9212380", "0.5219"),
+	},
+	64: {
+		radioactive(152, "151.9197995", "0.0020", 1.08e14*year),
+		stable(154, "153.9208741", "0.0218"),
+		stable(155, "154.9226305", "0.1480"),
+		stable(156, "155.9221312", "0.2047"),
+		stable(157, "156.9239686", "0.1565"),
+		stable(158, "157.9241123", "0.2484"),
+		stable(160, "159.9270624", "0.2186"),
+	},
+	65: {
+		stable(159, "158.9253547", "1"),
+	},
+	66: {
+		stable(156, "155.9242847", "0.00056"),
+		stable(158, "157.9244159", "0.00095"),
+		stable(160, "159.9252046", "0.02329"),
+		stable(161, "160.9269405", "0.18889"),
+		stable(162, "161.9268056", "0.25475"),
+		stable(163, "162.9287383", "0.24896"),
+		stable(164, "163.9291819", "0.28260"),
+	},
+	67: {
+		stable(165, "164.9303288", "1"),
+	},
+	68: {
+		stable(162, "161.9287884", "0.00139"),
+		stable(164, "163.9292088", "0.01601"),
+		stable(166, "165.9302995", "0.33503"),
+		stable(167, "166.9320546", "0.22869"),
+		stable(168, "167.9323767", "0.26978"),
+		stable(170, "169.9354702", "0.14910"),
+	},
+	69: {
+		stable(169, "168.9342179", "1"),
+	},
+	70: {
+		stable(168, "167.9338896", "0.00123"),
+		stable(170, "169.9347664", "0.02982"),
+		stable(171, "170.9363302", "0.1409"),
+		stable(172, "171.9363859", "0.2168"),
+		stable(173, "172.9382151", "0.16103"),
+		stable(174, "173.9388664", "0.32026"),
+		stable(176, "175.9425764", "0.12996"),
+	},
+	71: {
+		stable(175, "174.9407752", "0.97401"),
+		radioactive(176, "175.9426897", "0.02599", 3.76e10*year),
+	},
+	72: {
+		radioactive(174, "173.9400461", "0.0016", 2e15*year),
+		stable(176, "175.9414076", "0.0526"),
+		stable(177, "176.9432277", "0.1860"),
+		stable(178, "177.9437058", "0.2728"),
+		stable(179, "178.9458232", "0.1362"),
+		stable(180, "179.9465570", "0.3508"),
+	},
+	73: {
+		stable(180, "179.9474648", "0.0001201"),
+		stable(181, "180.9479958", "0.9998799"),
+	},
+	74: {
+		stable(180, "179.9467108", "0.0012"),
+		stable(182, "181.94820394", "0.2650"),
+		stable(183, "182.95022275", "0.1431"),
+		stable(184, "183.95093092", "0.3064"),
+		stable(186, "185.9543628", "0.2843"),
+	},
+	75: {
+		stable(185, "184.9529545", "0.3740"),
+		radioactive(187, "186.9557501", "0.6260", 4.12e10*year),
+	},
+	76: {
+		stable(184, "183.9524885", "0.0002"),
+		stable(186, "185.9538350", "0.0159"),
+		stable(187, "186.9557474", "0.0196"),
+		stable(188, "187.9558352", "0.1324"),
+		stable(189, "188.9581442", "0.1615"),
+		stable(190, "189.9584437", "0.2626"),
+		stable(192, "191.9614770", "0.4078"),
+	},
+	77: {
+		stable(191, "190.9605893", "0.373"),
+		stable(193, "192.9629216", "0.627"),
+		radioactive(192, "191.9626002", "0", 73.829*day),
+	},
+	78: {
+		radioactive(190, "189.9599297", "0.00012", 6.5e11*year),
+		stable(192, "191.9610387", "0.00782"),
+		stable(194, "193.9626809", "0.3286"),
+		stable(195, "194.9647917", "0.3378"),
+		stable(196, "195.96495209", "0.2521"),
+		stable(198, "197.9678949", "0.07356"),
+	},
+	79: {
+		stable(197, "196.96656879", "1"),
+		radioactive(198, "197.96824242", "0", 2.6941*day),
+	},
+	80: {
+		stable(196, "195.9658326", "0.0015"),
+		stable(198, "197.96676860", "0.0997"),
+		stable(199, "198.96828064", "0.1687"),
+		stable(200, "199.96832659", "0.2310"),
+		stable(201, "200.97030284", "0.1318"),
+		stable(202, "201.97064340", "0.2986"),
+		stable(204, "203.97349398", "0.0687"),
+	},
+	81: {
+		stable(203, "202.9723446", "0.2952"),
+		stable(205, "204.9744278", "0.7048"),
+		radioactive(201, "200.9708180", "0", 3.0421*day),
+	},
+	82: {
+		stable(204, "203.9730440", "0.014"),
+		stable(206, "205.9744657", "0.241"),
+		stable(207, "206.9758973", "0.221"),
+		stable(208, "207.9766525", "0.524"),
+		radioactive(210, "209.9841889", "0", 22.2*year),
+	},
+	83: {
+		radioactive(209, "208.9803991", "1", 2.01e19*year),
+	},
+	84: {
+		radioactive(209, "208.9824308", "0", 124*year),
+		radioactive(210, "209.9828741", "0", 138.376*day),
+	},
+	85: {
+		radioactive(210, "209.9871479", "0", 8.1*hour),
+		radioactive(211, "210.9874966", "0", 7.214*hour),
+	},
+	86: {
+		radioactive(211, "210.9906011", "0", 14.6*hour),
+		radioactive(220, "220.0113941", "0", 55.6),
+		radioactive(222, "222.0175782", "0", 3.8235*day),
+	},
+	87: {
+		radioactive(223, "223.0197360", "0", 22.00*minute),
+	},
+	88: {
+		radioactive(223, "223.0185023", "0", 11.43*day),
+		radioactive(226, "226.0254103", "0", 1600*year),
+		radioactive(228, "228.0310707", "0", 5.75*year),
+	},
+	89: {
+		radioactive(227, "227.0277523", "0", 21.772*year),
+	},
+	90: {
+		radioactive(230, "230.0331341", "0.0002", 7.54e4*year),
+		radioactive(232, "232.0380558", "0.9998", 1.405e10*year),
+	},
+	91: {
+		radioactive(231, "231.0358842", "1", 3.276e4*year),
+	},
+	92: {
+		radioactive(234, "234.0409523", "0.000054", 2.455e5*year),
+		radioactive(235, "235.0439301", "0.007204", 7.04e8*year),
+		radioactive(238, "238.0507884", "0.992742", 4.468e9*year),
+	},
+	93: {
+		radioactive(237, "237.0481736", "0", 2.144e6*year),
+	},
+	94: {
+		radioactive(238, "238.0495601", "0", 87.7*year),
+		radioactive(239, "239.0521636", "0", 24110*year),
+		radioactive(244, "244.0642053", "0", 8.0e7*year),
+	},
+	95: {
+		radioactive(241, "241.0568293", "0", 432.6*year),
+		radioactive(243, "243.0613813", "0", 7370*year),
+	},
+	96: {
+		radioactive(244, "244.0627528", "0", 18.1*year),
+		radioactive(247, "247.0703541", "0", 1.56e7*year),
+	},
+	97: {
+		radioactive(247, "247.0703073", "0", 1380*year),
+	},
+	98: {
+		radioactive(251, "251.0795886", "0", 898*year),
+		radioactive(252, "252.0816272", "0", 2.645*year),
+	},
+	99: {
+		radioactive(252, "252.082980", "0", 471.7*day),
+	},
+	100: {
+		radioactive(257, "257.0951061", "0", 100.5*day),
+	},
+	101: {
+		radioactive(258, "258.0984315", "0", 51.5*day),
+	},
+	102: {
+		radioactive(259, "259.10103", "0", 58*minute),
+	},
+	103: {
+		radioactive(262, "262.10961", "0", 3.6*hour),
+	},
+	104: {
+		radioactive(267, "267.12179", "0", 1.3*hour),
+	},
+	105: {
+		radioactive(268, "268.12567", "0", 16*hour),
+	},
+	106: {
+		radioactive(269, "269.12863", "0", 14*minute),
+	},
+	107: {
+		radioactive(270, "270.13336", "0", 61),
+	},
+	108: {
+		radioactive(269, "269.13375", "0", 16),
+	},
+	109: {
+		radioactive(278, "278.15631", "0", 4.5),
+	},
+	110: {
+		radioactive(281, "281.16451", "0", 12.7),
+	},
+	111: {
+		radioactive(282, "282.16912", "0", 100),
+	},
+	112: {
+		radioactive(285, "285.17712", "0", 28),
+	},
+	113: {
+		radioactive(286, "286.18221", "0", 8),
+	},
+	114: {
+		radioactive(289, "289.19042", "0", 1.9),
+	},
+	115: {
+		radioactive(290, "290.19598", "0", 0.65),
+	},
+	116: {
+		radioactive(293, "293.20449", "0", 0.057),
+	},
+	117: {
+		radioactive(294, "294.21046", "0", 0.051),
+	},
+	118: {
+		radioactive(294, "294.21392", "0", 0.0007),
+	},
+}
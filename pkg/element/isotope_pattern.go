@@ -0,0 +1,161 @@
+package element
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Peak is one line of a simulated mass spectrum.
+type Peak struct {
+	MZ        decimal.Decimal // mass-to-charge ratio, rounded to 6 decimal places
+	Intensity decimal.Decimal // percent of the tallest peak, rounded to 4 decimal places
+}
+
+// PatternOptions tunes an isotope pattern simulation. Zero values fall back to the defaults below.
+type PatternOptions struct {
+	Charge       int             // ionic charge; 0 reports neutral masses
+	Resolution   decimal.Decimal // peaks closer than this in u are merged, default 0.5 (unit resolution)
+	MinIntensity decimal.Decimal // peaks below this percent of the tallest are dropped, default 0.1
+	PruneBelow   float64         // intermediate peaks below this probability are discarded, default 1e-9
+}
+
+var (
+	defaultResolution   = decimal.NewFromFloat(0.5)
+	defaultMinIntensity = decimal.NewFromFloat(0.1)
+)
+
+const defaultPruneBelow = 1e-9
+
+// distributionPeak is an intermediate peak: a mass in u and the probability of finding it.
+type distributionPeak struct {
+	mass        float64
+	probability float64
+}
+
+// IsotopePattern simulates the isotopic distribution of the compound from natural abundances.
+func (c Compound) IsotopePattern(options PatternOptions) ([]Peak, error) {
+	if len(c.Elements) == 0 {
+		return nil, fmt.Errorf("no elements passed")
+	}
+	if options.Resolution.IsZero() {
+		options.Resolution = defaultResolution
+	}
+	if options.MinIntensity.IsZero() {
+		options.MinIntensity = defaultMinIntensity
+	}
+	if options.PruneBelow == 0 {
+		options.PruneBelow = defaultPruneBelow
+	}
+	if options.Resolution.IsNegative() || options.MinIntensity.IsNegative() || options.PruneBelow < 0 {
+		return nil, fmt.Errorf("pattern options must not be negative, got resolution %v, min intensity %v and prune below %v",
+			options.Resolution, options.MinIntensity, options.PruneBelow)
+	}
+	resolution := options.Resolution.InexactFloat64()
+
+	pattern := []distributionPeak{{mass: 0, probability: 1}}
+	for _, em := range c.Elements {
+		count, err := em.atomCount()
+		if err != nil {
+			return nil, err
+		}
+		atom, err := em.atomDistribution()
+		if err != nil {
+			return nil, err
+		}
+		// Raise the single-atom distribution to the atom count by repeated squaring.
+		for ; count > 0; count >>= 1 {
+			if count&1 == 1 {
+				pattern = convolve(pattern, atom, resolution, options.PruneBelow)
+			}
+			if count > 1 {
+				atom = convolve(atom, atom, resolution, options.PruneBelow)
+			}
+		}
+	}
+	if len(pattern) == 0 {
+		return nil, fmt.Errorf("every peak of %s is below the prune threshold of %v", c.Symbol, options.PruneBelow)
+	}
+	peaks := toPeaks(pattern, options)
+	if len(peaks) == 0 {
+		return nil, fmt.Errorf("every peak of %s is below %v%% of the tallest", c.Symbol, options.MinIntensity)
+	}
+	return peaks, nil
+}
+
+// IsotopePattern simulates the pattern of the ion, using the species' own charge.
+func (s Species) IsotopePattern(options PatternOptions) ([]Peak, error) {
+	options.Charge = s.Charge
+	return s.Compound.IsotopePattern(options)
+}
+
+// atomDistribution is the isotope distribution of a single atom.
+func (em ElementMoles) atomDistribution() ([]distributionPeak, error) {
+	if em.Isotope != nil {
+		return []distributionPeak{{mass: em.Isotope.ExactMass.InexactFloat64(), probability: 1}}, nil
+	}
+	var atom []distributionPeak
+	for _, isotope := range em.Element.naturalIsotopes() {
+		atom = append(atom, distributionPeak{mass: isotope.ExactMass.InexactFloat64(), probability: isotope.Abundance.InexactFloat64()})
+	}
+	if len(atom) == 0 {
+		return nil, fmt.Errorf("no natural isotopes of %s", em.Element.Symbol)
+	}
+	return atom, nil
+}
+
+// convolve combines two independent distributions, then prunes and merges the result.
+func convolve(a, b []distributionPeak, resolution float64, pruneBelow float64) []distributionPeak {
+	combined := make([]distributionPeak, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			if p := x.probability * y.probability; p >= pruneBelow {
+				combined = append(combined, distributionPeak{mass: x.mass + y.mass, probability: p})
+			}
+		}
+	}
+	return mergePeaks(combined, resolution)
+}
+
+// mergePeaks sorts by mass and folds every peak within resolution of the running centroid into it.
+func mergePeaks(peaks []distributionPeak, resolution float64) []distributionPeak {
+	sort.Slice(peaks, func(i, j int) bool { return peaks[i].mass < peaks[j].mass })
+	var merged []distributionPeak
+	for _, peak := range peaks {
+		last := len(merged) - 1
+		if last >= 0 && peak.mass-merged[last].mass <= resolution {
+			total := merged[last].probability + peak.probability
+			merged[last].mass = (merged[last].mass*merged[last].probability + peak.mass*peak.probability) / total
+			merged[last].probability = total
+			continue
+		}
+		merged = append(merged, peak)
+	}
+	return merged
+}
+
+// toPeaks converts masses to m/z, scales intensities to the tallest peak and drops small peaks.
+func toPeaks(pattern []distributionPeak, options PatternOptions) []Peak {
+	tallest := 0.0
+	for _, peak := range pattern {
+		tallest = math.Max(tallest, peak.probability)
+	}
+	electrons := float64(options.Charge) * electronMolarMass.InexactFloat64()
+	divisor := math.Max(math.Abs(float64(options.Charge)), 1)
+	minimum := options.MinIntensity.InexactFloat64()
+
+	var peaks []Peak
+	for _, peak := range pattern {
+		intensity := peak.probability / tallest * 100
+		if intensity < minimum {
+			continue
+		}
+		peaks = append(peaks, Peak{
+			MZ:        decimal.NewFromFloat((peak.mass - electrons) / divisor).Round(6),
+			Intensity: decimal.NewFromFloat(intensity).Round(4),
+		})
+	}
+	return peaks
+}
@@ -0,0 +1,129 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestIsotopePattern(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula       string
+		options       PatternOptions
+		expectedPeaks []Peak
+	}{
+		{
+			formula: "Cl2",
+			expectedPeaks: []Peak{
+				{MZ: decimal.RequireFromString("69.937705"), Intensity: decimal.RequireFromString("100")},
+				{MZ: decimal.RequireFromString("71.934755"), Intensity: decimal.RequireFromString("63.9916")},
+				{MZ: decimal.RequireFromString("73.931805"), Intensity: decimal.RequireFromString("10.2373")},
+			},
+		},
+		{
+			formula: "CH3Br",
+			options: PatternOptions{MinIntensity: decimal.NewFromInt(5)},
+			expectedPeaks: []Peak{
+				{MZ: decimal.RequireFromString("93.941813"), Intensity: decimal.RequireFromString("100")},
+				{MZ: decimal.RequireFromString("95.939765"), Intensity: decimal.RequireFromString("97.2779")},
+			},
+		},
+		{
+			formula: "Cl2",
+			options: PatternOptions{Charge: 2},
+			expectedPeaks: []Peak{
+				{MZ: decimal.RequireFromString("34.968304"), Intensity: decimal.RequireFromString("100")},
+				{MZ: decimal.RequireFromString("35.966829"), Intensity: decimal.RequireFromString("63.9916")},
+				{MZ: decimal.RequireFromString("36.965354"), Intensity: decimal.RequireFromString("10.2373")},
+			},
+		},
+		{
+			formula: "[13C]O2",
+			options: PatternOptions{MinIntensity: decimal.NewFromInt(1)},
+			expectedPeaks: []Peak{
+				{MZ: decimal.RequireFromString("44.993184"), Intensity: decimal.RequireFromString("100")},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			compound, err := NewCompound(test.formula, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			peaks, err := compound.IsotopePattern(test.options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			checkPeaks(t, test.expectedPeaks, peaks)
+		})
+	}
+}
+
+func TestSpeciesIsotopePattern(t *testing.T) {
+	chloride, err := NewSpecies("Cl-", NewPeriodicTable())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	peaks, err := chloride.IsotopePattern(PatternOptions{Charge: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	checkPeaks(t, []Peak{
+		{MZ: decimal.RequireFromString("34.969401"), Intensity: decimal.RequireFromString("100")},
+		{MZ: decimal.RequireFromString("36.966451"), Intensity: decimal.RequireFromString("31.9958")},
+	}, peaks)
+}
+
+func TestHighResolutionPattern(t *testing.T) {
+	// At 0.001 u the 13C and 2H contributions to M+1 of CH4 are resolved.
+	methane, _ := NewCompound("CH4", NewPeriodicTable())
+	peaks, err := methane.IsotopePattern(PatternOptions{Resolution: decimal.NewFromFloat(0.001), MinIntensity: decimal.NewFromFloat(0.01)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(peaks) != 3 {
+		t.Errorf("Expected 3 peaks, but got %v", peaks)
+	}
+}
+
+func TestIsotopePatternErrors(t *testing.T) {
+	if _, err := (Compound{}).IsotopePattern(PatternOptions{}); err == nil {
+		t.Errorf("Expected an error for an empty compound")
+	}
+	technetium, _ := NewCompound("Tc", NewPeriodicTable())
+	if _, err := technetium.IsotopePattern(PatternOptions{}); err == nil {
+		t.Errorf("Expected an error for an element with no natural isotopes")
+	}
+	co2, _ := NewCompound("CO2", NewPeriodicTable())
+	tests := []struct {
+		name    string
+		options PatternOptions
+	}{
+		{name: "negative resolution", options: PatternOptions{Resolution: decimal.NewFromFloat(-0.5)}},
+		{name: "negative minimum intensity", options: PatternOptions{MinIntensity: decimal.NewFromFloat(-1)}},
+		{name: "negative prune threshold", options: PatternOptions{PruneBelow: -1e-9}},
+		{name: "everything pruned", options: PatternOptions{PruneBelow: 1}},
+		{name: "everything below the minimum intensity", options: PatternOptions{MinIntensity: decimal.NewFromInt(101)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if peaks, err := co2.IsotopePattern(test.options); err == nil {
+				t.Errorf("Expected an error, but got %v", peaks)
+			}
+		})
+	}
+}
+
+func checkPeaks(t *testing.T, expected []Peak, actual []Peak) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d peaks, but got %v", len(expected), actual)
+	}
+	for i := range expected {
+		if !actual[i].MZ.Equal(expected[i].MZ) || !actual[i].Intensity.Equal(expected[i].Intensity) {
+			t.Errorf("Expected peak %v, but got %v", expected[i], actual[i])
+		}
+	}
+}
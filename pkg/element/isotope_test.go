@@ -0,0 +1,96 @@
+package element
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestIsotopeData(t *testing.T) {
+	pt := NewPeriodicTable()
+	tolerance := decimal.NewFromFloat(0.0005)
+	for _, element := range pt.Elements {
+		if len(element.Isotopes) == 0 {
+			t.Errorf("Expected isotopes for %s", element.Symbol)
+			continue
+		}
+		total := decimal.Zero
+		for _, isotope := range element.Isotopes {
+			total = total.Add(isotope.Abundance)
+			if isotope.ExactMass.Sub(decimal.NewFromInt(int64(isotope.MassNumber))).Abs().GreaterThan(decimal.NewFromFloat(0.5)) {
+				t.Errorf("Expected %d%s to have a mass near its mass number, but got %v", isotope.MassNumber, element.Symbol, isotope.ExactMass)
+			}
+			if isotope.Stable == (isotope.HalfLife > 0) {
+				t.Errorf("Expected %d%s to be either stable or have a half-life", isotope.MassNumber, element.Symbol)
+			}
+		}
+		if !total.IsZero() && total.Sub(decimal.NewFromInt(1)).Abs().GreaterThan(tolerance) {
+			t.Errorf("Expected the abundances of %s to sum to 1, but got %v", element.Symbol, total)
+		}
+	}
+}
+
+func TestFindIsotope(t *testing.T) {
+	pt := NewPeriodicTable()
+	carbon, _ := pt.FindElementBySymbol("C")
+	isotope, found := carbon.FindIsotope(13)
+	if !found {
+		t.Fatalf("Expected to find carbon-13")
+	}
+	if !isotope.ExactMass.Equal(decimal.RequireFromString("13.00335483507")) {
+		t.Errorf("Expected carbon-13 mass 13.00335483507, but got %v", isotope.ExactMass)
+	}
+	if _, found := carbon.FindIsotope(15); found {
+		t.Errorf("Expected not to find carbon-15")
+	}
+}
+
+func TestParseIsotopeLabels(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		formula           string
+		expectedElements  int
+		expectedMolarMass string
+		expectedError     bool
+	}{
+		{formula: "[13C]H4", expectedElements: 2, expectedMolarMass: "17.03535483507"},
+		{formula: "D2O", expectedElements: 2, expectedMolarMass: "20.02720355624"},
+		{formula: "^18O2", expectedElements: 1, expectedMolarMass: "35.99831922572"},
+		{formula: "[2H]Cl", expectedElements: 2, expectedMolarMass: "37.46410177812"},
+		{formula: "[13C]H3CH3", expectedElements: 3, expectedMolarMass: "31.06235483507"},
+		{formula: "T2", expectedElements: 1, expectedMolarMass: "6.0320985558"},
+		{formula: "[99C]H4", expectedError: true},
+		{formula: "[13C H4", expectedError: true},
+		{formula: "[2D]2O", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			compound, err := NewCompound(test.formula, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if len(compound.Elements) != test.expectedElements {
+				t.Errorf("Expected %d elements, but got %d", test.expectedElements, len(compound.Elements))
+			}
+			if !compound.MolarMass.Equal(decimal.RequireFromString(test.expectedMolarMass)) {
+				t.Errorf("Expected molar mass %s, but got %v", test.expectedMolarMass, compound.MolarMass)
+			}
+		})
+	}
+}
+
+func TestUnknownIsotopeSuggestion(t *testing.T) {
+	_, err := ParseCompoundElements("[99C]H4", NewPeriodicTable())
+	var formulaErr *FormulaError
+	if !errors.As(err, &formulaErr) {
+		t.Fatalf("Expected a FormulaError, but got %v", err)
+	}
+	expected := "did you mean 12C, 13C or 14C?"
+	if formulaErr.Suggestion != expected || formulaErr.Token != "[99C]" {
+		t.Errorf("Expected %q for [99C], but got %q for %q", expected, formulaErr.Suggestion, formulaErr.Token)
+	}
+}
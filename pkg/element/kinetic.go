@@ -0,0 +1,120 @@
+package element
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// gasMolarMass fills in the molar mass if needed and returns it in kg/mol, the unit the
+// kinetic theory formulas expect alongside R in J/(mol·K).
+func (compound *Compound) gasMolarMass() (float64, error) {
+	if compound.MolarMass.Equal(decimal.Zero) {
+		if err := compound.getMolarMass(); err != nil {
+			return 0, err
+		}
+	}
+	if !compound.MolarMass.IsPositive() {
+		return 0, fmt.Errorf("%s has no molar mass", compound.Symbol)
+	}
+	return compound.MolarMass.InexactFloat64() / 1000, nil
+}
+
+// EffusionRateRatio is how many times faster gas a effuses or diffuses than b by Graham's law,
+// rate a / rate b = √(Mb / Ma).
+func EffusionRateRatio(a, b Compound) (decimal.Decimal, error) {
+	ma, err := a.gasMolarMass()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	mb, err := b.gasMolarMass()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimal.NewFromFloat(math.Sqrt(mb / ma)), nil
+}
+
+// MolarMassFromEffusion finds the molar mass in g/mol of an unknown gas that takes unknownTime
+// to effuse when the known gas takes knownTime, Mu = Mk (tu / tk)². The times can be in any
+// unit as long as it is the same for both.
+func MolarMassFromEffusion(known Compound, knownTime, unknownTime decimal.Decimal) (decimal.Decimal, error) {
+	if !knownTime.IsPositive() || !unknownTime.IsPositive() {
+		return decimal.Zero, fmt.Errorf("effusion times must be positive, got %v and %v", knownTime, unknownTime)
+	}
+	if _, err := known.gasMolarMass(); err != nil {
+		return decimal.Zero, err
+	}
+	ratio := quotient(unknownTime, knownTime)
+	return known.MolarMass.Mul(ratio).Mul(ratio), nil
+}
+
+// molecularSpeed is √(factor·RT/M) in m/s.
+func (compound *Compound) molecularSpeed(factor float64, t Temperature) (decimal.Decimal, error) {
+	m, err := compound.gasMolarMass()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimal.NewFromFloat(math.Sqrt(factor * GasConstant.InexactFloat64() * k.InexactFloat64() / m)), nil
+}
+
+// RMSSpeed is the root-mean-square speed of the gas molecules in m/s, √(3RT/M).
+func (compound *Compound) RMSSpeed(t Temperature) (decimal.Decimal, error) {
+	return compound.molecularSpeed(3, t)
+}
+
+// MeanSpeed is the average speed of the gas molecules in m/s, √(8RT/πM).
+func (compound *Compound) MeanSpeed(t Temperature) (decimal.Decimal, error) {
+	return compound.molecularSpeed(8/math.Pi, t)
+}
+
+// MostProbableSpeed is the speed at the peak of the Maxwell–Boltzmann distribution in m/s, √(2RT/M).
+func (compound *Compound) MostProbableSpeed(t Temperature) (decimal.Decimal, error) {
+	return compound.molecularSpeed(2, t)
+}
+
+// SpeedDistributionPoint is one sample of the Maxwell–Boltzmann distribution: the probability
+// density, in s/m, of a molecule moving at Speed in m/s.
+type SpeedDistributionPoint struct {
+	Speed   decimal.Decimal
+	Density decimal.Decimal
+}
+
+// MaxwellBoltzmann samples the speed distribution f(v) = 4π (M/2πRT)^(3/2) v² e^(−Mv²/2RT)
+// at evenly spaced speeds from 0 to maxSpeed m/s, for plotting. A zero maxSpeed runs to four
+// times the most probable speed, which covers all but a sliver of the molecules.
+func (compound *Compound) MaxwellBoltzmann(t Temperature, maxSpeed decimal.Decimal, points int) ([]SpeedDistributionPoint, error) {
+	if points < 2 {
+		return nil, fmt.Errorf("a distribution table needs at least 2 points, got %d", points)
+	}
+	if maxSpeed.IsNegative() {
+		return nil, fmt.Errorf("maximum speed must be positive, got %v", maxSpeed)
+	}
+	m, err := compound.gasMolarMass()
+	if err != nil {
+		return nil, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return nil, err
+	}
+	rt := GasConstant.InexactFloat64() * k.InexactFloat64()
+	top := maxSpeed.InexactFloat64()
+	if maxSpeed.IsZero() {
+		top = 4 * math.Sqrt(2*rt/m)
+	}
+	norm := 4 * math.Pi * math.Pow(m/(2*math.Pi*rt), 1.5)
+	table := make([]SpeedDistributionPoint, points)
+	for i := range table {
+		v := top * float64(i) / float64(points-1)
+		table[i] = SpeedDistributionPoint{
+			Speed:   decimal.NewFromFloat(v),
+			Density: decimal.NewFromFloat(norm * v * v * math.Exp(-m*v*v/(2*rt))),
+		}
+	}
+	return table, nil
+}
@@ -0,0 +1,110 @@
+package element
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestEffusionRateRatio(t *testing.T) {
+	pt := NewPeriodicTable()
+	h2, _ := NewCompound("H2", pt)
+	o2, _ := NewCompound("O2", pt)
+	ratio, err := EffusionRateRatio(h2, o2)
+	if err != nil || math.Abs(ratio.InexactFloat64()-3.9835) > 1e-3 {
+		t.Errorf("Expected H2 to effuse about 3.98 times faster than O2, but got %v (%v)", ratio, err)
+	}
+	if _, err := EffusionRateRatio(Compound{Symbol: "XX"}, o2); err == nil {
+		t.Errorf("Expected an error for a compound without elements")
+	}
+}
+
+func TestMolarMassFromEffusion(t *testing.T) {
+	pt := NewPeriodicTable()
+	o2, _ := NewCompound("O2", pt)
+	tests := []struct {
+		name          string
+		knownTime     string
+		unknownTime   string
+		expected      string
+		expectedError bool
+	}{
+		{name: "twice as long", knownTime: "30", unknownTime: "60", expected: "127.992"},
+		{name: "same time", knownTime: "12.5", unknownTime: "12.5", expected: "31.998"},
+		{name: "no time", knownTime: "0", unknownTime: "60", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			molarMass, err := MolarMassFromEffusion(o2, decimal.RequireFromString(test.knownTime), decimal.RequireFromString(test.unknownTime))
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if !test.expectedError && !molarMass.Round(3).Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s g/mol, but got %v", test.expected, molarMass)
+			}
+		})
+	}
+}
+
+func TestMolecularSpeeds(t *testing.T) {
+	pt := NewPeriodicTable()
+	n2, _ := NewCompound("N2", pt)
+	room, _ := NewTemperature(decimal.RequireFromString("298.15"), Kelvin)
+	tests := []struct {
+		name     string
+		speed    func(Temperature) (decimal.Decimal, error)
+		expected float64
+	}{
+		{name: "rms", speed: n2.RMSSpeed, expected: 515.2},
+		{name: "mean", speed: n2.MeanSpeed, expected: 474.7},
+		{name: "most probable", speed: n2.MostProbableSpeed, expected: 420.7},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			speed, err := test.speed(room)
+			if err != nil || math.Abs(speed.InexactFloat64()-test.expected) > 0.1 {
+				t.Errorf("Expected %v m/s, but got %v (%v)", test.expected, speed, err)
+			}
+		})
+	}
+	zero, _ := NewTemperature(decimal.Zero, Kelvin)
+	if _, err := n2.RMSSpeed(zero); err == nil {
+		t.Errorf("Expected an error at absolute zero")
+	}
+}
+
+func TestMaxwellBoltzmann(t *testing.T) {
+	pt := NewPeriodicTable()
+	n2, _ := NewCompound("N2", pt)
+	room, _ := NewTemperature(decimal.RequireFromString("298.15"), Kelvin)
+	table, err := n2.MaxwellBoltzmann(room, decimal.Zero, 401)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	peak, area := table[0], 0.0
+	for i, point := range table {
+		if point.Density.GreaterThan(peak.Density) {
+			peak = point
+		}
+		if i > 0 {
+			width := point.Speed.Sub(table[i-1].Speed).InexactFloat64()
+			area += width * (point.Density.InexactFloat64() + table[i-1].Density.InexactFloat64()) / 2
+		}
+	}
+	vp, _ := n2.MostProbableSpeed(room)
+	if math.Abs(peak.Speed.InexactFloat64()-vp.InexactFloat64()) > 5 {
+		t.Errorf("Expected the peak near %v m/s, but got %v", vp, peak.Speed)
+	}
+	if math.Abs(area-1) > 1e-3 {
+		t.Errorf("Expected the distribution to hold all the molecules, but got %v", area)
+	}
+
+	table, err = n2.MaxwellBoltzmann(room, decimal.NewFromInt(1000), 11)
+	if err != nil || len(table) != 11 || !table[10].Speed.Equal(decimal.NewFromInt(1000)) || !table[0].Density.IsZero() {
+		t.Errorf("Expected 11 points from 0 to 1000 m/s, but got %v (%v)", table, err)
+	}
+	if _, err := n2.MaxwellBoltzmann(room, decimal.Zero, 1); err == nil {
+		t.Errorf("Expected an error for a single point")
+	}
+}
@@ -76,8 +76,8 @@ func TestMolesOfProperty(t *testing.T) {
 		{
 			name: "1 kg of 1g/mol",
 			property: Mass{value: decimal.NewFromInt(1),
-					unit: gram,
-					prefix: kilo,
+					unit: Gram,
+					prefix: Kilo,
 				 },
 			value: decimal.NewFromInt(1),
 			expectedMoles: decimal.NewFromInt(1000),
@@ -86,8 +86,8 @@ func TestMolesOfProperty(t *testing.T) {
 		{
 			name: "1 kg of 10g/mol",
 			property: Mass{value: decimal.NewFromInt(1),
-					unit: gram,
-					prefix: kilo,
+					unit: Gram,
+					prefix: Kilo,
 				 },
 			value: decimal.NewFromInt(10),
 			expectedMoles: decimal.NewFromInt(100),
@@ -96,37 +96,37 @@ func TestMolesOfProperty(t *testing.T) {
 		{
 			name: "1 lb of 1g/mol",
 			property: Mass{value: decimal.NewFromInt(1),
-					unit: pound,
-					prefix: none,
+					unit: Pound,
+					prefix: NoPrefix,
 				 },
 			value: decimal.NewFromInt(1),
-			expectedMoles: decimal.NewFromFloat(453.592),
+			expectedMoles: decimal.NewFromFloat(453.59237),
 			expectedError: false,
 		},
 		{
 			name: "1 L of 1mol/L",
-			property: Volume{value: decimal.NewFromFloat(1), unit: none},
+			property: Volume{value: decimal.NewFromFloat(1), unit: Liter, prefix: NoPrefix},
 			value: decimal.NewFromInt(1),
 			expectedMoles: decimal.NewFromFloat(1),
 			expectedError: false,
 		},
 		{
 			name: "1 mL of 10mol/L",
-			property: Volume{value: decimal.NewFromFloat(1), unit: milli},
+			property: Volume{value: decimal.NewFromFloat(1), unit: Liter, prefix: Milli},
 			value: decimal.NewFromInt(10),
 			expectedMoles: decimal.NewFromFloat(.01),
 			expectedError: false,
 		},
 		{
 			name: "10 L of 0.05mol/L",
-			property: Volume{value: decimal.NewFromFloat(10), unit: none},
+			property: Volume{value: decimal.NewFromFloat(10), unit: Liter, prefix: NoPrefix},
 			value: decimal.NewFromFloat(0.05),
 			expectedMoles: decimal.NewFromFloat(0.5),
 			expectedError: false,
 		},
 		{
 			name: "1 μL of 1mol/L",
-			property: Volume{value: decimal.NewFromFloat(1), unit: micro},
+			property: Volume{value: decimal.NewFromFloat(1), unit: Liter, prefix: Micro},
 			value: decimal.NewFromInt(1),
 			expectedMoles: decimal.NewFromFloat(0.000001),
 			expectedError: false,
@@ -134,8 +134,8 @@ func TestMolesOfProperty(t *testing.T) {
 		{
 			name: "0 molar mass throws error",
 			property: Mass{value: decimal.NewFromInt(1),
-					unit: gram,
-					prefix: kilo,
+					unit: Gram,
+					prefix: Kilo,
 				 },
 			value: decimal.NewFromInt(0),
 			expectedMoles: decimal.NewFromInt(0),
@@ -143,7 +143,7 @@ func TestMolesOfProperty(t *testing.T) {
 		},
 		{
 			name: "0 molarity throws an error",
-			property: Volume{value: decimal.NewFromFloat(100), unit: kilo},
+			property: Volume{value: decimal.NewFromFloat(100), unit: Liter, prefix: Kilo},
 			value: decimal.NewFromInt(0),
 			expectedMoles: decimal.NewFromFloat(0),
 			expectedError: true,
@@ -151,8 +151,8 @@ func TestMolesOfProperty(t *testing.T) {
 		{
 			name: "0 mass throws error",
 			property: Mass{value: decimal.NewFromInt(0),
-					unit: gram,
-					prefix: kilo,
+					unit: Gram,
+					prefix: Kilo,
 				 },
 			value: decimal.NewFromInt(1),
 			expectedMoles: decimal.NewFromInt(0),
@@ -160,7 +160,7 @@ func TestMolesOfProperty(t *testing.T) {
 		},
 		{
 			name: "0 volume throws an error",
-			property: Volume{value: decimal.NewFromFloat(0), unit: kilo},
+			property: Volume{value: decimal.NewFromFloat(0), unit: Liter, prefix: Kilo},
 			value: decimal.NewFromInt(1),
 			expectedMoles: decimal.NewFromFloat(0),
 			expectedError: true,
@@ -184,7 +184,7 @@ func TestMolesOfProperty(t *testing.T) {
 	
 }
 func TestMolesOfElements(t *testing.T) {
-	var preciseOMoles, _ = decimal.NewFromString("17.7192324520282518") //too precise for floats
+	var preciseOMoles, _ = decimal.NewFromString("17.7195594255890993") //too precise for floats
 	var testElementMoles = []struct {
 		element     ElementMoles  
 		expectedError bool
@@ -193,31 +193,31 @@ func TestMolesOfElements(t *testing.T) {
 	}{
 		{element: ElementMoles{
 			Element: Element{AtomicNumber: 1, Symbol: "H", Name: "Hydrogen", AtomicWeight: decimal.NewFromFloat(1.008)}},
-			massForMoles: Mass{value: decimal.NewFromFloat(10.08), unit: gram, prefix: none},
+			massForMoles: Mass{value: decimal.NewFromFloat(10.08), unit: Gram, prefix: NoPrefix},
 			expectedMoles: decimal.NewFromFloat(10),
 			expectedError: false,
 		},
 		{element: ElementMoles{
 			Element: Element{AtomicNumber: 6, Symbol: "C", Name: "Carbon", AtomicWeight: decimal.NewFromFloat(12.011)}},
-			massForMoles: Mass{value: decimal.NewFromFloat(7.2066), unit: gram, prefix: kilo},
+			massForMoles: Mass{value: decimal.NewFromFloat(7.2066), unit: Gram, prefix: Kilo},
 			expectedMoles: decimal.NewFromFloat(600),
 			expectedError: false,
 		},
 		{element: ElementMoles{
 			Element: Element{AtomicNumber: 8, Symbol: "O", Name: "Oxygen", AtomicWeight: decimal.NewFromFloat(15.999)}},
-			massForMoles: Mass{value: decimal.NewFromFloat(10), unit: ounce, prefix: none},
+			massForMoles: Mass{value: decimal.NewFromFloat(10), unit: Ounce, prefix: NoPrefix},
 			expectedMoles: preciseOMoles,
 			expectedError: false,
 		},
 		{element: ElementMoles{
-			Element: Element{AtomicNumber: 211, Symbol: "XX", Name: "Baddium", AtomicWeight: decimal.NewFromFloat(453.592)}},
-			massForMoles: Mass{value: decimal.NewFromFloat(100), unit: pound, prefix: none},
+			Element: Element{AtomicNumber: 211, Symbol: "XX", Name: "Baddium", AtomicWeight: decimal.NewFromFloat(453.59237)}},
+			massForMoles: Mass{value: decimal.NewFromFloat(100), unit: Pound, prefix: NoPrefix},
 			expectedMoles: decimal.NewFromFloat(100),
 			expectedError: false,
 		},
 		{element: ElementMoles{
 			Element: Element{AtomicNumber: 17, Symbol: "Cl", Name: "Chlorine", AtomicWeight: decimal.NewFromFloat(35.45)}},
-			massForMoles: Mass{value: decimal.NewFromFloat(3.545), unit: gram, prefix: milli},
+			massForMoles: Mass{value: decimal.NewFromFloat(3.545), unit: Gram, prefix: Milli},
 			expectedMoles: decimal.NewFromFloat(0.0001),
 			expectedError: false,
 		},
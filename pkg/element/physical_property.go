@@ -2,37 +2,88 @@ package element
 
 import (
 	"fmt"
-	"log"
+	"math/big"
 
 	"github.com/shopspring/decimal"
 )
 
 
+// Prefix is an SI prefix as the power of ten it multiplies a unit by.
 type Prefix float64
 
 const (
-	none Prefix = 1
-	kilo Prefix = 1000
-	hecto Prefix = 100
-	deca Prefix = 10
-	deci Prefix = 0.1
-	centi Prefix = 0.01
-	milli Prefix = 0.001
-	micro Prefix = 0.000001
+	Quecto Prefix = 1e-30
+	Ronto Prefix = 1e-27
+	Yocto Prefix = 1e-24
+	Zepto Prefix = 1e-21
+	Atto Prefix = 1e-18
+	Femto Prefix = 1e-15
+	Pico Prefix = 1e-12
+	Nano Prefix = 1e-9
+	Micro Prefix = 0.000001
+	Milli Prefix = 0.001
+	Centi Prefix = 0.01
+	Deci Prefix = 0.1
+	NoPrefix Prefix = 1
+	Deca Prefix = 10
+	Hecto Prefix = 100
+	Kilo Prefix = 1000
+	Mega Prefix = 1e6
+	Giga Prefix = 1e9
+	Tera Prefix = 1e12
+	Peta Prefix = 1e15
+	Exa Prefix = 1e18
+	Zetta Prefix = 1e21
+	Yotta Prefix = 1e24
+	Ronna Prefix = 1e27
+	Quetta Prefix = 1e30
 )
 
-type MassUnit float64
+// MassUnit is a unit of mass defined by its exact size in grams.
+// Units that are not a terminating decimal of grams, like the slug, keep a divisor.
+type MassUnit struct {
+	symbol string
+	grams  string
+	per    string
+}
 
-const (
-	// Catch for things like Ton, and Slug
-	unknownMass MassUnit = -1
+var (
 	//Metric
-	gram    MassUnit = 1
-	// Imperial
-	ounce   MassUnit = 28.349
-	pound   MassUnit = 453.592
+	Gram      = MassUnit{symbol: "g", grams: "1"}
+	MetricTon = MassUnit{symbol: "t", grams: "1000000"}
+	Dalton    = MassUnit{symbol: "Da", grams: "0.0000000000000000000000016605390666"} // CODATA 2018
+	// Avoirdupois, from the international pound of 1959
+	Grain    = MassUnit{symbol: "gr", grams: "0.06479891"}
+	Ounce    = MassUnit{symbol: "oz", grams: "28.349523125"}
+	Pound    = MassUnit{symbol: "lb", grams: "453.59237"}
+	Stone    = MassUnit{symbol: "st", grams: "6350.29318"}
+	ShortTon = MassUnit{symbol: "ton", grams: "907184.74"}
+	LongTon  = MassUnit{symbol: "long ton", grams: "1016046.9088"}
+	// Troy
+	TroyOunce = MassUnit{symbol: "ozt", grams: "31.1034768"}
+	// Imperial gravitational: one pound-force accelerates a slug at one foot per second squared
+	Slug = MassUnit{symbol: "slug", grams: "4448.2216152605", per: "0.3048"}
 )
 
+func (u MassUnit) String() string {
+	return u.symbol
+}
+
+// factor is the exact number of grams in one unit.
+func (u MassUnit) factor() *big.Rat {
+	return ratio(u.grams, u.per)
+}
+
+// quotient divides to at least decimal.DivisionPrecision places, and further for tiny results
+// such as daltons in grams, so about twenty significant digits survive.
+func quotient(a, b decimal.Decimal) decimal.Decimal {
+	places := 20 - (int32(a.NumDigits()) + a.Exponent()) + (int32(b.NumDigits()) + b.Exponent())
+	if places < int32(decimal.DivisionPrecision) {
+		places = int32(decimal.DivisionPrecision)
+	}
+	return a.DivRound(b, places)
+}
+
 type Mass struct {
 	value decimal.Decimal
 	unit  MassUnit
@@ -40,25 +91,97 @@ type Mass struct {
 }
 
 
+// VolumeUnit is the size of a volume unit in liters.
+type VolumeUnit float64
+
+const (
+	//Metric
+	Liter           VolumeUnit = 1
+	CubicMeter      VolumeUnit = 1000 // its prefix is cubed, so deci gives dm³
+	CubicCentimeter VolumeUnit = 0.001
+	// US customary, liquid measure
+	FluidOunce VolumeUnit = 0.0295735295625
+	Cup        VolumeUnit = 0.2365882365
+	Pint       VolumeUnit = 0.473176473
+	Quart      VolumeUnit = 0.946352946
+	USGallon   VolumeUnit = 3.785411784
+	// Imperial
+	ImperialGallon VolumeUnit = 4.54609
+)
+
 type Volume struct {
-	value decimal.Decimal
-	unit  Prefix 
+	value  decimal.Decimal
+	unit   VolumeUnit
+	prefix Prefix
 }
 
-type Property interface {
+// Measurement is anything that converts to a standard unit: grams, liters or kelvin.
+type Measurement interface {
 	convertToStandard() (decimal.Decimal, error)
+}
+
+// Property is a Measurement that also gives an amount in moles.
+type Property interface {
+	Measurement
 	getMoles(decimal.Decimal) (decimal.Decimal, error)
 }
 
-func convertToStandardValue(p Property) (decimal.Decimal, error) {
+func convertToStandardValue(p Measurement) (decimal.Decimal, error) {
     return p.convertToStandard()
 }
 
+// convertToStandard returns the mass in grams.
 func (m Mass) convertToStandard() (decimal.Decimal, error) {
 	if m.value.Equal(decimal.Zero){
 		return decimal.Zero, fmt.Errorf("empty property passed")
 	}	
-	return m.value.Mul( decimal.NewFromFloat(float64(m.unit))).Mul(decimal.NewFromFloat(float64(m.prefix))), nil
+	grams, err := m.Convert(Gram, NoPrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return grams.value, nil
+}
+
+// Quantity is the mass as a Quantity in its own unit, for dimensional analysis.
+func (m Mass) Quantity() (Quantity, error) {
+	units, err := massUnitPowers(m.unit, m.prefix)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: m.value, units: units}, nil
+}
+
+func massUnitPowers(unit MassUnit, prefix Prefix) ([]unitPower, error) {
+	if unit.grams == "" {
+		return nil, fmt.Errorf("mass has no unit")
+	}
+	def, found := unitRegistry[unit.symbol].withPrefix(prefix)
+	if !found {
+		return nil, fmt.Errorf("mass has an unknown prefix %v", float64(prefix))
+	}
+	return []unitPower{{def, 1}}, nil
+}
+
+// Value is the mass in its own unit and prefix.
+func (m Mass) Value() decimal.Decimal {
+	return m.value
+}
+
+// Convert expresses the mass in another unit and prefix.
+func (m Mass) Convert(unit MassUnit, prefix Prefix) (Mass, error) {
+	q, err := m.Quantity()
+	if err != nil {
+		return Mass{}, err
+	}
+	target, err := massUnitPowers(unit, prefix)
+	if err != nil {
+		return Mass{}, err
+	}
+	conversion, err := q.convertTo(target)
+	if err != nil {
+		return Mass{}, err
+	}
+	return Mass{value: conversion.Result.value, unit: unit, prefix: prefix}, nil
 }
 
 func NewMass(value decimal.Decimal, options ...interface{}) (Mass, error) {
@@ -67,8 +190,8 @@ func NewMass(value decimal.Decimal, options ...interface{}) (Mass, error) {
 	}
 	mass := Mass{
 		value:  value,
-		unit:   gram, 
-		prefix: none, 
+		unit:   Gram, 
+		prefix: NoPrefix, 
 	}
 
 	for _, opt := range options {
@@ -78,18 +201,392 @@ func NewMass(value decimal.Decimal, options ...interface{}) (Mass, error) {
 		case Prefix:
 			mass.prefix = v
 		default:
-			log.Printf("%v is unexpected", v)
+			return Mass{}, fmt.Errorf("%v is not a mass unit or prefix", v)
 		}
 	}
 
 	return mass, nil
 }
 
+// convertToStandard returns the volume in liters.
 func (v Volume) convertToStandard() (decimal.Decimal, error) {
 	if v.value.Equal(decimal.Zero){
 		return decimal.Zero, fmt.Errorf("empty property passed")
 	}
-	return v.value.Mul(decimal.NewFromFloat(float64(v.unit))), nil
+	liters, err := v.Convert(Liter, NoPrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return liters.value, nil
+}
+
+// Quantity is the volume as a Quantity in its own unit, for dimensional analysis.
+func (v Volume) Quantity() (Quantity, error) {
+	units, err := volumeUnitPowers(v.unit, v.prefix)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: v.value, units: units}, nil
+}
+
+// volumeUnitPowers gives cubic meters and centimeters as a cubed length, and the rest as volume units.
+func volumeUnitPowers(unit VolumeUnit, prefix Prefix) ([]unitPower, error) {
+	base, power := volumeUnitSymbols[unit], 1
+	switch unit {
+	case CubicMeter:
+		base, power = "m", 3
+	case CubicCentimeter:
+		base, power = "cm", 3
+	}
+	if base == "" {
+		return nil, fmt.Errorf("volume has no unit")
+	}
+	if prefix != NoPrefix && unit != Liter && unit != CubicMeter {
+		return nil, fmt.Errorf("prefixes only apply to liters and cubic meters")
+	}
+	def, found := lookupUnit(base)
+	if found {
+		def, found = def.withPrefix(prefix)
+	}
+	if !found {
+		return nil, fmt.Errorf("volume has an unknown prefix %v", float64(prefix))
+	}
+	return []unitPower{{def, power}}, nil
+}
+
+// Value is the volume in its own unit and prefix.
+func (v Volume) Value() decimal.Decimal {
+	return v.value
+}
+
+// Convert expresses the volume in another unit and prefix.
+func (v Volume) Convert(unit VolumeUnit, prefix Prefix) (Volume, error) {
+	q, err := v.Quantity()
+	if err != nil {
+		return Volume{}, err
+	}
+	target, err := volumeUnitPowers(unit, prefix)
+	if err != nil {
+		return Volume{}, err
+	}
+	conversion, err := q.convertTo(target)
+	if err != nil {
+		return Volume{}, err
+	}
+	return Volume{value: conversion.Result.value, unit: unit, prefix: prefix}, nil
+}
+
+// NewVolume builds a Volume in liters unless a VolumeUnit or Prefix is passed.
+// Prefixes only apply to liters and cubic meters.
+func NewVolume(value decimal.Decimal, options ...interface{}) (Volume, error) {
+	if !value.IsPositive() {
+		return Volume{}, fmt.Errorf("volume must be positive, got %v", value)
+	}
+	volume := Volume{
+		value:  value,
+		unit:   Liter,
+		prefix: NoPrefix,
+	}
+
+	for _, opt := range options {
+		switch v := opt.(type) {
+		case VolumeUnit:
+			volume.unit = v
+		case Prefix:
+			volume.prefix = v
+		default:
+			return Volume{}, fmt.Errorf("%v is not a volume unit or prefix", v)
+		}
+	}
+	if volume.prefix != NoPrefix && volume.unit != Liter && volume.unit != CubicMeter {
+		return Volume{}, fmt.Errorf("prefixes only apply to liters and cubic meters")
+	}
+
+	return volume, nil
+}
+
+// PressureUnit is a unit of pressure, named by its symbol.
+type PressureUnit string
+
+const (
+	Pascal              PressureUnit = "Pa"
+	Bar                 PressureUnit = "bar"
+	Atmosphere          PressureUnit = "atm"
+	Torr                PressureUnit = "torr" // exactly 1/760 atm
+	MillimeterOfMercury PressureUnit = "mmHg" // 133.322387415 Pa, a hair more than a torr
+	PSI                 PressureUnit = "psi"
+)
+
+type Pressure struct {
+	value  decimal.Decimal
+	unit   PressureUnit
+	prefix Prefix
+}
+
+// NewPressure builds a Pressure in atmospheres unless a PressureUnit or Prefix is passed.
+// Prefixes only apply to pascals and bars, as in kPa or mbar.
+func NewPressure(value decimal.Decimal, options ...interface{}) (Pressure, error) {
+	if !value.IsPositive() {
+		return Pressure{}, fmt.Errorf("pressure must be positive, got %v", value)
+	}
+	pressure := Pressure{
+		value:  value,
+		unit:   Atmosphere,
+		prefix: NoPrefix,
+	}
+
+	for _, opt := range options {
+		switch v := opt.(type) {
+		case PressureUnit:
+			pressure.unit = v
+		case Prefix:
+			pressure.prefix = v
+		default:
+			return Pressure{}, fmt.Errorf("%v is not a pressure unit or prefix", v)
+		}
+	}
+	if _, err := pressureUnitPowers(pressure.unit, pressure.prefix); err != nil {
+		return Pressure{}, err
+	}
+
+	return pressure, nil
+}
+
+// convertToStandard returns the pressure in atmospheres.
+func (p Pressure) convertToStandard() (decimal.Decimal, error) {
+	if p.value.Equal(decimal.Zero){
+		return decimal.Zero, fmt.Errorf("empty property passed")
+	}
+	atmospheres, err := p.Convert(Atmosphere, NoPrefix)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return atmospheres.value, nil
+}
+
+// Value is the pressure in its own unit and prefix.
+func (p Pressure) Value() decimal.Decimal {
+	return p.value
+}
+
+// Quantity is the pressure as a Quantity in its own unit, for dimensional analysis.
+func (p Pressure) Quantity() (Quantity, error) {
+	units, err := pressureUnitPowers(p.unit, p.prefix)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: p.value, units: units}, nil
+}
+
+// Convert expresses the pressure in another unit and prefix.
+func (p Pressure) Convert(unit PressureUnit, prefix Prefix) (Pressure, error) {
+	q, err := p.Quantity()
+	if err != nil {
+		return Pressure{}, err
+	}
+	target, err := pressureUnitPowers(unit, prefix)
+	if err != nil {
+		return Pressure{}, err
+	}
+	conversion, err := q.convertTo(target)
+	if err != nil {
+		return Pressure{}, err
+	}
+	return Pressure{value: conversion.Result.value, unit: unit, prefix: prefix}, nil
+}
+
+func pressureUnitPowers(unit PressureUnit, prefix Prefix) ([]unitPower, error) {
+	def, found := unitRegistry[string(unit)]
+	if !found || def.dimension != pressureDimension {
+		return nil, fmt.Errorf("%q is not a pressure unit", string(unit))
+	}
+	if prefix != NoPrefix && !def.prefixed {
+		return nil, fmt.Errorf("prefixes only apply to pascals and bars")
+	}
+	def, found = def.withPrefix(prefix)
+	if !found {
+		return nil, fmt.Errorf("pressure has an unknown prefix %v", float64(prefix))
+	}
+	return []unitPower{{def, 1}}, nil
+}
+
+// TemperatureScale is the scale a temperature is read on.
+type TemperatureScale int
+
+const (
+	Kelvin TemperatureScale = iota + 1
+	Celsius
+	Fahrenheit
+	Rankine
+)
+
+var (
+	celsiusOffset    = decimal.RequireFromString("273.15")
+	fahrenheitOffset = decimal.RequireFromString("459.67")
+	nineFifths       = decimal.RequireFromString("1.8")
+)
+
+func (s TemperatureScale) String() string {
+	switch s {
+	case Kelvin:
+		return "K"
+	case Celsius:
+		return "°C"
+	case Fahrenheit:
+		return "°F"
+	case Rankine:
+		return "°R"
+	}
+	return "unknown scale"
+}
+
+// Temperature is an absolute temperature, which can never be below absolute zero.
+type Temperature struct {
+	value decimal.Decimal
+	scale TemperatureScale
+}
+
+// TemperatureDifference is a change in temperature, such as ΔT in q = mcΔT.
+// Its size depends only on the degree: a change of 1 °C is 1 K, and 1 °F is 1 °R.
+type TemperatureDifference struct {
+	value decimal.Decimal
+	scale TemperatureScale
+}
+
+// NewTemperature builds a Temperature, rejecting values below absolute zero.
+func NewTemperature(value decimal.Decimal, scale TemperatureScale) (Temperature, error) {
+	t := Temperature{value: value, scale: scale}
+	kelvin, err := t.convertToStandard()
+	if err != nil {
+		return Temperature{}, err
+	}
+	if kelvin.IsNegative() {
+		return Temperature{}, fmt.Errorf("%v %v is below absolute zero", value, scale)
+	}
+	return t, nil
+}
+
+// convertToStandard returns the temperature in kelvin.
+func (t Temperature) convertToStandard() (decimal.Decimal, error) {
+	switch t.scale {
+	case Kelvin:
+		return t.value, nil
+	case Celsius:
+		return t.value.Add(celsiusOffset), nil
+	case Fahrenheit:
+		return quotient(t.value.Add(fahrenheitOffset), nineFifths), nil
+	case Rankine:
+		return quotient(t.value, nineFifths), nil
+	}
+	return decimal.Zero, fmt.Errorf("temperature has no scale")
+}
+
+// Value is the temperature on its own scale.
+func (t Temperature) Value() decimal.Decimal {
+	return t.value
+}
+
+// Convert reads the temperature on another scale.
+func (t Temperature) Convert(scale TemperatureScale) (Temperature, error) {
+	if scale == t.scale {
+		return t, nil
+	}
+	kelvin, err := t.convertToStandard()
+	if err != nil {
+		return Temperature{}, err
+	}
+	var value decimal.Decimal
+	switch scale {
+	case Kelvin:
+		value = kelvin
+	case Celsius:
+		value = kelvin.Sub(celsiusOffset)
+	case Fahrenheit:
+		value = kelvin.Mul(nineFifths).Sub(fahrenheitOffset)
+	case Rankine:
+		value = kelvin.Mul(nineFifths)
+	default:
+		return Temperature{}, fmt.Errorf("cannot convert to an unknown temperature scale")
+	}
+	return Temperature{value: value, scale: scale}, nil
+}
+
+// Quantity is the temperature in kelvin, for dimensional analysis.
+func (t Temperature) Quantity() (Quantity, error) {
+	kelvin, err := t.convertToStandard()
+	if err != nil {
+		return Quantity{}, err
+	}
+	return NewQuantity(kelvin, "K")
+}
+
+// Sub is the difference t - o, on t's scale.
+func (t Temperature) Sub(o Temperature) (TemperatureDifference, error) {
+	other, err := o.Convert(t.scale)
+	if err != nil {
+		return TemperatureDifference{}, err
+	}
+	return TemperatureDifference{value: t.value.Sub(other.value), scale: t.scale}, nil
+}
+
+// Add changes the temperature by d, failing if that would go below absolute zero.
+func (t Temperature) Add(d TemperatureDifference) (Temperature, error) {
+	change, err := d.Convert(t.scale)
+	if err != nil {
+		return Temperature{}, err
+	}
+	return NewTemperature(t.value.Add(change.value), t.scale)
+}
+
+// NewTemperatureDifference builds a change in temperature, which may be negative.
+func NewTemperatureDifference(value decimal.Decimal, scale TemperatureScale) (TemperatureDifference, error) {
+	if scale < Kelvin || scale > Rankine {
+		return TemperatureDifference{}, fmt.Errorf("temperature difference has no scale")
+	}
+	return TemperatureDifference{value: value, scale: scale}, nil
+}
+
+// convertToStandard returns the difference in kelvins.
+func (d TemperatureDifference) convertToStandard() (decimal.Decimal, error) {
+	switch d.scale {
+	case Kelvin, Celsius:
+		return d.value, nil
+	case Fahrenheit, Rankine:
+		return quotient(d.value, nineFifths), nil
+	}
+	return decimal.Zero, fmt.Errorf("temperature difference has no scale")
+}
+
+// Value is the difference in degrees of its own scale.
+func (d TemperatureDifference) Value() decimal.Decimal {
+	return d.value
+}
+
+// Convert expresses the difference in degrees of another scale.
+func (d TemperatureDifference) Convert(scale TemperatureScale) (TemperatureDifference, error) {
+	if scale == d.scale {
+		return d, nil
+	}
+	kelvins, err := d.convertToStandard()
+	if err != nil {
+		return TemperatureDifference{}, err
+	}
+	switch scale {
+	case Kelvin, Celsius:
+		return TemperatureDifference{value: kelvins, scale: scale}, nil
+	case Fahrenheit, Rankine:
+		return TemperatureDifference{value: kelvins.Mul(nineFifths), scale: scale}, nil
+	}
+	return TemperatureDifference{}, fmt.Errorf("cannot convert to an unknown temperature scale")
+}
+
+// Quantity is the difference in kelvins, for dimensional analysis such as J/(g·K).
+func (d TemperatureDifference) Quantity() (Quantity, error) {
+	kelvins, err := d.convertToStandard()
+	if err != nil {
+		return Quantity{}, err
+	}
+	return NewQuantity(kelvins, "K")
 }
 
 func (compound *Compound) getMolarMass() error {
@@ -98,7 +595,7 @@ func (compound *Compound) getMolarMass() error {
 	}
 	var totalMass decimal.Decimal = decimal.Zero
 	for _, em := range compound.Elements {
-		totalMass = totalMass.Add(em.Element.AtomicWeight.Mul(em.Moles))
+		totalMass = totalMass.Add(em.atomicMass().Mul(em.Moles))
 	}
 	compound.MolarMass = totalMass
 	return nil
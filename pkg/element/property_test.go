@@ -20,64 +20,64 @@ func TestConvertMassToStandard(t *testing.T) {
 		{
 			name:           "1 kilogram to grams",
 			value:          1,
-			unit:           gram,
-			prefix:         kilo,
+			unit:           Gram,
+			prefix:         Kilo,
 			expectedResult: 1000,
 			expectedError:  false,
 		},
 		{
 			name:           "1 hectogram to grams",
 			value:          1,
-			unit:           gram,
-			prefix:         hecto,
+			unit:           Gram,
+			prefix:         Hecto,
 			expectedResult: 100,
 			expectedError:  false,
 		},
 		{
 			name:           "2 pounds to grams",
 			value:          2,
-			unit:           pound,
-			prefix:         none,
-			expectedResult: 907.184, // 2 * 453.592
+			unit:           Pound,
+			prefix:         NoPrefix,
+			expectedResult: 907.18474, // 2 * 453.59237
 			expectedError:  false,
 		},
 		{
 			name:           "3 ounces to grams",
 			value:          3,
-			unit:           ounce,
-			prefix:         none,
-			expectedResult: 85.047, // 3 * 28.349
+			unit:           Ounce,
+			prefix:         NoPrefix,
+			expectedResult: 85.048569375, // 3 * 28.349523125
 			expectedError:  false,
 		},
 		{
 			name:           "100 milligrams to grams",
 			value:          100,
-			unit:           gram,
-			prefix:         milli,
+			unit:           Gram,
+			prefix:         Milli,
 			expectedResult: 0.1,
 			expectedError:  false,
 		},
 		{
 			name:           "1 micrograms to grams",
 			value:          1,
-			unit:           gram,
-			prefix:         micro,
+			unit:           Gram,
+			prefix:         Micro,
 			expectedResult: .000001,
 			expectedError:  false,
 		},
 		{
 			name:           "1000 pounds to grams",
 			value:          1000,
-			unit:           pound,
-			prefix:         none,
-			expectedResult: 453592,
+			unit:           Pound,
+			prefix:         NoPrefix,
+			expectedResult: 453592.37,
 			expectedError:  false,
 		},
 		{
 			name:           "Invalid unit (unknown)",
 			value:          2,
-			unit:           unknownMass,
-			prefix:         none,
+			unit:           MassUnit{},
+			prefix:         NoPrefix,
 			expectedResult: 2,
 			expectedError:  true,
 		},
@@ -107,18 +107,18 @@ func TestConvertVolume(t *testing.T) {
 		expectedResult float64
 		expectedError bool
 	}{
-		{prefix: none, value: 2, expectedResult: 2, expectedError:  false}, 
-		{prefix: kilo, value: 8.25, expectedResult:  8250, expectedError:  false},
-		{prefix: hecto, value: 9, expectedResult:  900, expectedError:  false},
-		{prefix: deca, value: 10, expectedResult:  100, expectedError:  false},
-		{prefix: deci, value: 1005, expectedResult:  100.5, expectedError:  false},
-		{prefix: centi, value: 888, expectedResult:  8.88, expectedError:  false},
-		{prefix: milli, value: 1618, expectedResult:  1.618, expectedError:  false},
-		{prefix: micro, value: 1, expectedResult:  0.000001, expectedError:  false},
+		{prefix: NoPrefix, value: 2, expectedResult: 2, expectedError:  false}, 
+		{prefix: Kilo, value: 8.25, expectedResult:  8250, expectedError:  false},
+		{prefix: Hecto, value: 9, expectedResult:  900, expectedError:  false},
+		{prefix: Deca, value: 10, expectedResult:  100, expectedError:  false},
+		{prefix: Deci, value: 1005, expectedResult:  100.5, expectedError:  false},
+		{prefix: Centi, value: 888, expectedResult:  8.88, expectedError:  false},
+		{prefix: Milli, value: 1618, expectedResult:  1.618, expectedError:  false},
+		{prefix: Micro, value: 1, expectedResult:  0.000001, expectedError:  false},
 	}
 	for _, test := range volumeTests {
 		t.Run(test.name, func(t *testing.T) {
-			v := Volume{value: decimal.NewFromFloat(test.value), unit: test.prefix}
+			v := Volume{value: decimal.NewFromFloat(test.value), unit: Liter, prefix: test.prefix}
 			result, err := convertToStandardValue(v)
 			if !test.expectedError && err !=nil  {
 				t.Errorf("Unexpected error for %s: %s", test.name, err)
@@ -153,9 +153,9 @@ func TestMolarMass(t *testing.T) {
 }
 
 func TestNewMass(t *testing.T){
-	var gram MassUnit = gram
-	var pound MassUnit = pound
-	var kilo Prefix = kilo
+	var Gram MassUnit = Gram
+	var Pound MassUnit = Pound
+	var Kilo Prefix = Kilo
 	
 	tests := []struct {
 		name		string
@@ -168,8 +168,8 @@ func TestNewMass(t *testing.T){
 		{
 			name:           "1 kilogram to grams",
 			value:          1,
-			unit:           &gram,
-			prefix:         &kilo,
+			unit:           &Gram,
+			prefix:         &Kilo,
 			expectedResult: 1000,
 			expectedError:  false,
 		},
@@ -177,16 +177,16 @@ func TestNewMass(t *testing.T){
 			name:           ".5 kilogram with no unit to grams",
 			value:          .5,
 			unit:           nil,
-			prefix:         &kilo,
+			prefix:         &Kilo,
 			expectedResult: 500,
 			expectedError:  false,
 		},
 		{
 			name:           "1 pounds to grams no prefix",
 			value:          1,
-			unit:           &pound,
+			unit:           &Pound,
 			prefix:         nil,
-			expectedResult: 453.592,
+			expectedResult: 453.59237,
 			expectedError:  false,
 		},
 		{
@@ -200,8 +200,8 @@ func TestNewMass(t *testing.T){
 		{
 			name:           "Invalid value",
 			value:          0,
-			unit:           &gram,
-			prefix:         &kilo,
+			unit:           &Gram,
+			prefix:         &Kilo,
 			expectedResult: 1000,
 			expectedError:  true,
 		},
@@ -236,3 +236,373 @@ func TestNewMass(t *testing.T){
 		})
 	}
 }
+
+func TestConvertVolumeUnits(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		unit           VolumeUnit
+		prefix         Prefix
+		expectedResult string
+		expectedError  bool
+	}{
+		{name: "250 cm3 to liters", value: "250", unit: CubicCentimeter, prefix: NoPrefix, expectedResult: "0.25"},
+		{name: "2 dm3 to liters", value: "2", unit: CubicMeter, prefix: Deci, expectedResult: "2"},
+		{name: "1 cubic meter to liters", value: "1", unit: CubicMeter, prefix: NoPrefix, expectedResult: "1000"},
+		{name: "5 cm3 as cubic centimeters", value: "5", unit: CubicMeter, prefix: Centi, expectedResult: "0.005"},
+		{name: "8 fluid ounces to liters", value: "8", unit: FluidOunce, prefix: NoPrefix, expectedResult: "0.2365882365"},
+		{name: "1 cup to liters", value: "1", unit: Cup, prefix: NoPrefix, expectedResult: "0.2365882365"},
+		{name: "2 pints to liters", value: "2", unit: Pint, prefix: NoPrefix, expectedResult: "0.946352946"},
+		{name: "4 quarts to liters", value: "4", unit: Quart, prefix: NoPrefix, expectedResult: "3.785411784"},
+		{name: "1 US gallon to liters", value: "1", unit: USGallon, prefix: NoPrefix, expectedResult: "3.785411784"},
+		{name: "1 imperial gallon to liters", value: "1", unit: ImperialGallon, prefix: NoPrefix, expectedResult: "4.54609"},
+		{name: "no unit", value: "1", prefix: NoPrefix, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v := Volume{value: decimal.RequireFromString(test.value), unit: test.unit, prefix: test.prefix}
+			result, err := convertToStandardValue(v)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if !test.expectedError && !result.Equal(decimal.RequireFromString(test.expectedResult)) {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNewVolume(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		options        []interface{}
+		expectedResult string
+		expectedError  bool
+	}{
+		{name: "liters by default", value: "1.5", expectedResult: "1.5"},
+		{name: "milliliters", value: "25.0", options: []interface{}{Milli}, expectedResult: "0.025"},
+		{name: "cubic decimeters", value: "3", options: []interface{}{CubicMeter, Deci}, expectedResult: "3"},
+		{name: "gallons", value: "2", options: []interface{}{USGallon}, expectedResult: "7.570823568"},
+		{name: "prefixed gallons", value: "2", options: []interface{}{USGallon, Kilo}, expectedError: true},
+		{name: "prefixed cubic centimeters", value: "2", options: []interface{}{CubicCentimeter, Milli}, expectedError: true},
+		{name: "mass unit", value: "2", options: []interface{}{Gram}, expectedError: true},
+		{name: "zero", value: "0", expectedError: true},
+		{name: "negative", value: "-1", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := NewVolume(decimal.RequireFromString(test.value), test.options...)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			result, err := convertToStandardValue(v)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Equal(decimal.RequireFromString(test.expectedResult)) {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestNewMassRejectsUnknownOptions(t *testing.T) {
+	if _, err := NewMass(decimal.NewFromInt(1), Liter); err == nil {
+		t.Errorf("Expected an error for a volume unit passed to NewMass")
+	}
+}
+
+func TestConvertMass(t *testing.T) {
+	tests := []struct {
+		name           string
+		mass           Mass
+		unit           MassUnit
+		prefix         Prefix
+		expectedResult string
+		expectedError  bool
+	}{
+		{name: "grams to milligrams", mass: Mass{value: decimal.RequireFromString("2.5"), unit: Gram, prefix: NoPrefix}, unit: Gram, prefix: Milli, expectedResult: "2500"},
+		{name: "kilograms to megagrams", mass: Mass{value: decimal.RequireFromString("1500"), unit: Gram, prefix: Kilo}, unit: Gram, prefix: Mega, expectedResult: "1.5"},
+		{name: "nanograms to picograms", mass: Mass{value: decimal.RequireFromString("3"), unit: Gram, prefix: Nano}, unit: Gram, prefix: Pico, expectedResult: "3000"},
+		{name: "yoctograms to quectograms", mass: Mass{value: decimal.RequireFromString("1"), unit: Gram, prefix: Yocto}, unit: Gram, prefix: Quecto, expectedResult: "1000000"},
+		{name: "pounds to grams", mass: Mass{value: decimal.RequireFromString("2"), unit: Pound, prefix: NoPrefix}, unit: Gram, prefix: NoPrefix, expectedResult: "907.18474"},
+		{name: "grams to pounds", mass: Mass{value: decimal.RequireFromString("907.18474"), unit: Gram, prefix: NoPrefix}, unit: Pound, prefix: NoPrefix, expectedResult: "2"},
+		{name: "unknown target", mass: Mass{value: decimal.RequireFromString("1"), unit: Gram, prefix: NoPrefix}, unit: MassUnit{}, prefix: NoPrefix, expectedError: true},
+		{name: "empty mass", mass: Mass{}, unit: Gram, prefix: NoPrefix, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.mass.Convert(test.unit, test.prefix)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if !result.Value().Equal(decimal.RequireFromString(test.expectedResult)) || result.unit != test.unit || result.prefix != test.prefix {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result.Value())
+			}
+		})
+	}
+}
+
+func TestConvertVolumeToUnit(t *testing.T) {
+	tests := []struct {
+		name           string
+		volume         Volume
+		unit           VolumeUnit
+		prefix         Prefix
+		expectedResult string
+		expectedError  bool
+	}{
+		{name: "liters to milliliters", volume: Volume{value: decimal.RequireFromString("0.25"), unit: Liter, prefix: NoPrefix}, unit: Liter, prefix: Milli, expectedResult: "250"},
+		{name: "milliliters to cubic centimeters", volume: Volume{value: decimal.RequireFromString("12"), unit: Liter, prefix: Milli}, unit: CubicMeter, prefix: Centi, expectedResult: "12"},
+		{name: "gallons to liters", volume: Volume{value: decimal.RequireFromString("2"), unit: USGallon, prefix: NoPrefix}, unit: Liter, prefix: NoPrefix, expectedResult: "7.570823568"},
+		{name: "liters to cups", volume: Volume{value: decimal.RequireFromString("0.473176473"), unit: Liter, prefix: NoPrefix}, unit: Cup, prefix: NoPrefix, expectedResult: "2"},
+		{name: "microliters to nanoliters", volume: Volume{value: decimal.RequireFromString("1.5"), unit: Liter, prefix: Micro}, unit: Liter, prefix: Nano, expectedResult: "1500"},
+		{name: "prefixed pints", volume: Volume{value: decimal.RequireFromString("1"), unit: Liter, prefix: NoPrefix}, unit: Pint, prefix: Kilo, expectedError: true},
+		{name: "empty volume", volume: Volume{}, unit: Liter, prefix: NoPrefix, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.volume.Convert(test.unit, test.prefix)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if !result.Value().Equal(decimal.RequireFromString(test.expectedResult)) || result.unit != test.unit || result.prefix != test.prefix {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result.Value())
+			}
+		})
+	}
+}
+
+func TestExactMassUnits(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		unit           MassUnit
+		expectedResult string
+	}{
+		{name: "troy ounce", value: "1", unit: TroyOunce, expectedResult: "31.1034768"},
+		{name: "7000 grains make a pound", value: "7000", unit: Grain, expectedResult: "453.59237"},
+		{name: "stone is 14 pounds", value: "1", unit: Stone, expectedResult: "6350.29318"},
+		{name: "short ton is 2000 pounds", value: "1", unit: ShortTon, expectedResult: "907184.74"},
+		{name: "long ton is 2240 pounds", value: "1", unit: LongTon, expectedResult: "1016046.9088"},
+		{name: "metric ton", value: "2.5", unit: MetricTon, expectedResult: "2500000"},
+		{name: "dalton", value: "12", unit: Dalton, expectedResult: "0.0000000000000000000000199264687992"},
+		{name: "0.3048 slugs", value: "0.3048", unit: Slug, expectedResult: "4448.2216152605"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := Mass{value: decimal.RequireFromString(test.value), unit: test.unit, prefix: NoPrefix}
+			result, err := convertToStandardValue(m)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Equal(decimal.RequireFromString(test.expectedResult)) {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestConvertSlugRoundTrip(t *testing.T) {
+	slug := Mass{value: decimal.NewFromInt(1), unit: Slug, prefix: NoPrefix}
+	kilograms, err := slug.Convert(Gram, Kilo)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !kilograms.Value().Round(6).Equal(decimal.RequireFromString("14.593903")) {
+		t.Errorf("Expected 14.593903 kg, but got %v", kilograms.Value())
+	}
+	back, err := kilograms.Convert(Slug, NoPrefix)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !back.Value().Round(12).Equal(decimal.NewFromInt(1)) {
+		t.Errorf("Expected 1 slug, but got %v", back.Value())
+	}
+}
+
+func TestNewTemperature(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		scale          TemperatureScale
+		expectedKelvin string
+		expectedError  bool
+	}{
+		{name: "freezing water in celsius", value: "0", scale: Celsius, expectedKelvin: "273.15"},
+		{name: "freezing water in fahrenheit", value: "32", scale: Fahrenheit, expectedKelvin: "273.15"},
+		{name: "body temperature", value: "98.6", scale: Fahrenheit, expectedKelvin: "310.15"},
+		{name: "absolute zero in rankine", value: "0", scale: Rankine, expectedKelvin: "0"},
+		{name: "boiling water in rankine", value: "671.67", scale: Rankine, expectedKelvin: "373.15"},
+		{name: "absolute zero in celsius", value: "-273.15", scale: Celsius, expectedKelvin: "0"},
+		{name: "below absolute zero in celsius", value: "-273.16", scale: Celsius, expectedError: true},
+		{name: "below absolute zero in fahrenheit", value: "-460", scale: Fahrenheit, expectedError: true},
+		{name: "negative kelvin", value: "-1", scale: Kelvin, expectedError: true},
+		{name: "no scale", value: "300", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			temperature, err := NewTemperature(decimal.RequireFromString(test.value), test.scale)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			result, err := convertToStandardValue(temperature)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Equal(decimal.RequireFromString(test.expectedKelvin)) {
+				t.Errorf("Expected %s K, but got %v", test.expectedKelvin, result)
+			}
+		})
+	}
+}
+
+func TestConvertTemperature(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		from     TemperatureScale
+		to       TemperatureScale
+		expected string
+	}{
+		{name: "celsius to fahrenheit", value: "100", from: Celsius, to: Fahrenheit, expected: "212"},
+		{name: "fahrenheit to celsius", value: "-40", from: Fahrenheit, to: Celsius, expected: "-40"},
+		{name: "kelvin to celsius", value: "298.15", from: Kelvin, to: Celsius, expected: "25"},
+		{name: "celsius to rankine", value: "0", from: Celsius, to: Rankine, expected: "491.67"},
+		{name: "fahrenheit to kelvin", value: "212", from: Fahrenheit, to: Kelvin, expected: "373.15"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			temperature, err := NewTemperature(decimal.RequireFromString(test.value), test.from)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			result, err := temperature.Convert(test.to)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Value().Round(10).Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s %v, but got %v", test.expected, test.to, result.Value())
+			}
+		})
+	}
+}
+
+func TestTemperatureDifference(t *testing.T) {
+	cold, _ := NewTemperature(decimal.RequireFromString("20.0"), Celsius)
+	hot, _ := NewTemperature(decimal.RequireFromString("350.15"), Kelvin)
+	change, err := hot.Sub(cold)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !change.Value().Equal(decimal.RequireFromString("57")) {
+		t.Errorf("Expected a change of 57 K, but got %v", change.Value())
+	}
+	fahrenheit, err := change.Convert(Fahrenheit)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !fahrenheit.Value().Equal(decimal.RequireFromString("102.6")) {
+		t.Errorf("Expected a change of 102.6 °F, but got %v", fahrenheit.Value())
+	}
+	warmed, err := cold.Add(fahrenheit)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !warmed.Value().Equal(decimal.RequireFromString("77")) {
+		t.Errorf("Expected 77 °C, but got %v", warmed.Value())
+	}
+	drop, _ := NewTemperatureDifference(decimal.RequireFromString("-300"), Kelvin)
+	if _, err := cold.Add(drop); err == nil {
+		t.Errorf("Expected an error cooling below absolute zero")
+	}
+	q, err := change.Quantity()
+	if err != nil || q.String() != "57 K" {
+		t.Errorf("Expected 57 K, but got %v (%v)", q, err)
+	}
+}
+
+func TestConvertPressure(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		unit           PressureUnit
+		prefix         Prefix
+		toUnit         PressureUnit
+		toPrefix       Prefix
+		expectedResult string
+	}{
+		{name: "atmospheres to kilopascals", value: "1", unit: Atmosphere, prefix: NoPrefix, toUnit: Pascal, toPrefix: Kilo, expectedResult: "101.325"},
+		{name: "atmospheres to torr", value: "2", unit: Atmosphere, prefix: NoPrefix, toUnit: Torr, toPrefix: NoPrefix, expectedResult: "1520"},
+		{name: "torr to mmHg", value: "760", unit: Torr, prefix: NoPrefix, toUnit: MillimeterOfMercury, toPrefix: NoPrefix, expectedResult: "759.9998917256"},
+		{name: "bar to kilopascals", value: "1", unit: Bar, prefix: NoPrefix, toUnit: Pascal, toPrefix: Kilo, expectedResult: "100"},
+		{name: "millibar to hectopascals", value: "1013.25", unit: Bar, prefix: Milli, toUnit: Pascal, toPrefix: Hecto, expectedResult: "1013.25"},
+		{name: "atmospheres to psi", value: "1", unit: Atmosphere, prefix: NoPrefix, toUnit: PSI, toPrefix: NoPrefix, expectedResult: "14.6959487755"},
+		{name: "psi to pascals", value: "1", unit: PSI, prefix: NoPrefix, toUnit: Pascal, toPrefix: NoPrefix, expectedResult: "6894.7572931684"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := NewPressure(decimal.RequireFromString(test.value), test.unit, test.prefix)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			result, err := p.Convert(test.toUnit, test.toPrefix)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Value().Round(10).Equal(decimal.RequireFromString(test.expectedResult)) {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result.Value())
+			}
+		})
+	}
+}
+
+func TestNewPressure(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		options        []interface{}
+		expectedResult string
+		expectedError  bool
+	}{
+		{name: "atmospheres by default", value: "0.95", expectedResult: "0.95"},
+		{name: "torr", value: "380", options: []interface{}{Torr}, expectedResult: "0.5"},
+		{name: "kilopascals", value: "202.65", options: []interface{}{Pascal, Kilo}, expectedResult: "2"},
+		{name: "prefixed atmospheres", value: "1", options: []interface{}{Atmosphere, Kilo}, expectedError: true},
+		{name: "unknown unit", value: "1", options: []interface{}{PressureUnit("inHg")}, expectedError: true},
+		{name: "volume unit", value: "1", options: []interface{}{Liter}, expectedError: true},
+		{name: "zero", value: "0", expectedError: true},
+		{name: "negative", value: "-1", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := NewPressure(decimal.RequireFromString(test.value), test.options...)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			result, err := convertToStandardValue(p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !result.Equal(decimal.RequireFromString(test.expectedResult)) {
+				t.Errorf("Test %s failed: expected %v, got %v", test.name, test.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package element
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Quantity is a value with units that are tracked through multiplication, division and conversion.
+type Quantity struct {
+	value decimal.Decimal
+	units []unitPower
+}
+
+// NewQuantity pairs a value with a unit expression such as "g", "mol/L" or "J/(mol·K)".
+func NewQuantity(value decimal.Decimal, unit string) (Quantity, error) {
+	units, err := parseUnits(unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{value: value, units: units}, nil
+}
+
+// Value is the number in front of the units.
+func (q Quantity) Value() decimal.Decimal {
+	return q.value
+}
+
+// Unit is the units written out, such as "g/mol".
+func (q Quantity) Unit() string {
+	return formatUnits(q.units)
+}
+
+// Dimension is the combined dimension of the units.
+func (q Quantity) Dimension() Dimension {
+	return unitsDimension(q.units)
+}
+
+func (q Quantity) String() string {
+	if len(q.units) == 0 {
+		return q.value.String()
+	}
+	return q.value.String() + " " + q.Unit()
+}
+
+// Mul multiplies two quantities and their units.
+func (q Quantity) Mul(o Quantity) Quantity {
+	return Quantity{value: q.value.Mul(o.value), units: multiplyUnits(q.units, o.units)}
+}
+
+// Div divides two quantities and their units.
+func (q Quantity) Div(o Quantity) (Quantity, error) {
+	if o.value.IsZero() {
+		return Quantity{}, fmt.Errorf("cannot divide %v by zero", q)
+	}
+	inverse := make([]unitPower, len(o.units))
+	for i, up := range o.units {
+		inverse[i] = unitPower{up.unit, -up.power}
+	}
+	return Quantity{value: quotient(q.value, o.value), units: multiplyUnits(q.units, inverse)}, nil
+}
+
+// ConversionStep is one conversion factor, written Numerator over Denominator and raised to Power.
+type ConversionStep struct {
+	Numerator   Quantity
+	Denominator Quantity
+	Power       int
+}
+
+// Conversion is a factor-label conversion from one quantity to another, with each factor used.
+type Conversion struct {
+	From   Quantity
+	Result Quantity
+	Steps  []ConversionStep
+}
+
+// ConvertTo converts the quantity into the given units, which must have the same dimension.
+// Each unit is swapped for the target unit of the same kind in its own step, so "mg/mL" to
+// "g/L" takes one step for mass and one for volume; anything left over, like L·atm to J,
+// is converted in a single final step.
+func (q Quantity) ConvertTo(unit string) (Conversion, error) {
+	target, err := parseUnits(unit)
+	if err != nil {
+		return Conversion{}, err
+	}
+	return q.convertTo(target)
+}
+
+func (q Quantity) convertTo(target []unitPower) (Conversion, error) {
+	if from, to := unitsDimension(q.units), unitsDimension(target); from != to {
+		return Conversion{}, fmt.Errorf("cannot convert %s (%v) to %s (%v)", formatUnits(q.units), from, formatUnits(target), to)
+	}
+	conversion := Conversion{From: q}
+	used := make([]bool, len(target))
+	var fromLeft, toLeft []unitPower
+	for _, up := range q.units {
+		matched := false
+		for i, tp := range target {
+			if used[i] || tp.power != up.power || tp.unit.dimension != up.unit.dimension {
+				continue
+			}
+			used[i], matched = true, true
+			if tp.unit.symbol != up.unit.symbol {
+				conversion.Steps = append(conversion.Steps, conversionStep([]unitPower{{up.unit, 1}}, []unitPower{{tp.unit, 1}}, up.power))
+			}
+			break
+		}
+		if !matched {
+			fromLeft = append(fromLeft, up)
+		}
+	}
+	for i, tp := range target {
+		if !used[i] {
+			toLeft = append(toLeft, tp)
+		}
+	}
+	if len(fromLeft) > 0 || len(toLeft) > 0 {
+		conversion.Steps = append(conversion.Steps, conversionStep(fromLeft, toLeft, 1))
+	}
+
+	factor := new(big.Rat).Quo(unitsFactor(q.units), unitsFactor(target))
+	value := new(big.Rat).Mul(q.value.Rat(), factor)
+	conversion.Result = Quantity{value: ratDecimal(value), units: target}
+	return conversion, nil
+}
+
+// conversionStep writes the factor that turns from into to, with the larger number on top
+// so it reads "1 lb / 453.59237 g" rather than "0.0022… lb / 1 g". A negative power
+// means the units were in the denominator, so the factor is flipped.
+func conversionStep(from, to []unitPower, power int) ConversionStep {
+	size := new(big.Rat).Quo(unitsFactor(from), unitsFactor(to)) // how many to in one from
+	one := decimal.NewFromInt(1)
+	var top, bottom Quantity
+	if size.Cmp(big.NewRat(1, 1)) >= 0 {
+		top, bottom = Quantity{value: ratDecimal(size), units: to}, Quantity{value: one, units: from}
+	} else {
+		top, bottom = Quantity{value: one, units: to}, Quantity{value: ratDecimal(new(big.Rat).Inv(size)), units: from}
+	}
+	if power < 0 {
+		top, bottom = bottom, top
+	}
+	return ConversionStep{Numerator: top, Denominator: bottom, Power: abs(power)}
+}
+
+// Text writes the conversion out as "25 mL × (1 L / 1000 mL) = 0.025 L".
+func (c Conversion) Text() string {
+	var b strings.Builder
+	b.WriteString(c.From.String())
+	for _, step := range c.Steps {
+		fmt.Fprintf(&b, " × (%s / %s)", step.Numerator, step.Denominator)
+		if step.Power > 1 {
+			b.WriteString(superscript(step.Power))
+		}
+	}
+	b.WriteString(" = " + c.Result.String())
+	return b.String()
+}
+
+// LaTeX writes the conversion as an equation with each factor as a \frac.
+func (c Conversion) LaTeX() string {
+	var b strings.Builder
+	b.WriteString(latexQuantity(c.From))
+	for _, step := range c.Steps {
+		fraction := fmt.Sprintf(`\frac{%s}{%s}`, latexQuantity(step.Numerator), latexQuantity(step.Denominator))
+		if step.Power > 1 {
+			fraction = fmt.Sprintf(`\left(%s\right)^{%d}`, fraction, step.Power)
+		}
+		b.WriteString(` \times ` + fraction)
+	}
+	b.WriteString(" = " + latexQuantity(c.Result))
+	return b.String()
+}
+
+// formatUnits writes units as "g·m²/(s²·mol)", or "1" when there are none.
+func formatUnits(units []unitPower) string {
+	var numerator, denominator []string
+	for _, up := range units {
+		text := up.unit.symbol
+		if abs(up.power) > 1 {
+			text += superscript(abs(up.power))
+		}
+		if up.power > 0 {
+			numerator = append(numerator, text)
+		} else {
+			denominator = append(denominator, text)
+		}
+	}
+	text := strings.Join(numerator, "·")
+	if text == "" {
+		text = "1"
+	}
+	switch len(denominator) {
+	case 0:
+	case 1:
+		text += "/" + denominator[0]
+	default:
+		text += "/(" + strings.Join(denominator, "·") + ")"
+	}
+	return text
+}
+
+var latexSymbols = strings.NewReplacer("µ", `\mu `, "Å", `\AA`, " ", `\,`)
+
+func latexQuantity(q Quantity) string {
+	var numerator, denominator []string
+	for _, up := range q.units {
+		text := `\mathrm{` + latexSymbols.Replace(up.unit.symbol) + `}`
+		if abs(up.power) > 1 {
+			text += fmt.Sprintf("^{%d}", abs(up.power))
+		}
+		if up.power > 0 {
+			numerator = append(numerator, text)
+		} else {
+			denominator = append(denominator, text)
+		}
+	}
+	units := strings.Join(numerator, `\,`)
+	if len(denominator) > 0 {
+		if units == "" {
+			units = "1"
+		}
+		units = fmt.Sprintf(`\frac{%s}{%s}`, units, strings.Join(denominator, `\,`))
+	}
+	if units == "" {
+		return q.value.String()
+	}
+	return q.value.String() + `\,` + units
+}
+
+// ratDecimal converts an exact ratio to a decimal, keeping about twenty significant digits when it does not terminate.
+func ratDecimal(r *big.Rat) decimal.Decimal {
+	if r.IsInt() {
+		return decimal.NewFromBigInt(r.Num(), 0)
+	}
+	return quotient(decimal.NewFromBigInt(r.Num(), 0), decimal.NewFromBigInt(r.Denom(), 0))
+}
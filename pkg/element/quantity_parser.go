@@ -0,0 +1,171 @@
+package element
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// quantityPattern splits text such as "3.2e-3 kg" or "1.20 × 10^3 mL" into mantissa, exponent and unit.
+var quantityPattern = regexp.MustCompile(`^\s*([+-]?(?:\d+\.?\d*|\.\d+))(?:[eE]([+-]?\d+)|\s*[×xX*]\s*10\^?([+-]?\d+))?\s*(.*?)\s*$`)
+
+var prefixSymbols = []struct {
+	symbol string
+	prefix Prefix
+}{
+	{"da", Deca}, // before "d" so "dag" is not read as deci-ag
+	{"Q", Quetta},
+	{"R", Ronna},
+	{"Y", Yotta},
+	{"Z", Zetta},
+	{"E", Exa},
+	{"P", Peta},
+	{"T", Tera},
+	{"G", Giga},
+	{"M", Mega},
+	{"k", Kilo},
+	{"h", Hecto},
+	{"d", Deci},
+	{"c", Centi},
+	{"m", Milli},
+	{"µ", Micro}, // micro sign
+	{"μ", Micro}, // Greek mu
+	{"u", Micro},
+	{"n", Nano},
+	{"p", Pico},
+	{"f", Femto},
+	{"a", Atto},
+	{"z", Zepto},
+	{"y", Yocto},
+	{"r", Ronto},
+	{"q", Quecto},
+}
+
+// massSymbols and volumeSymbols are units written on their own; those marked true also take a prefix.
+var massSymbols = map[string]struct {
+	unit     MassUnit
+	prefixed bool
+}{
+	"g":    {Gram, true},
+	"Da":   {Dalton, true},
+	"t":    {MetricTon, false},
+	"gr":   {Grain, false},
+	"oz":   {Ounce, false},
+	"ozt":  {TroyOunce, false},
+	"lb":   {Pound, false},
+	"lbs":  {Pound, false},
+	"st":   {Stone, false},
+	"ton":  {ShortTon, false},
+	"slug": {Slug, false},
+}
+
+var volumeSymbols = map[string]struct {
+	unit     VolumeUnit
+	prefixed bool
+}{
+	"L":         {Liter, true},
+	"l":         {Liter, true},
+	"m3":        {CubicMeter, true},
+	"cc":        {CubicCentimeter, false},
+	"fl oz":     {FluidOunce, false},
+	"floz":      {FluidOunce, false},
+	"cup":       {Cup, false},
+	"pt":        {Pint, false},
+	"qt":        {Quart, false},
+	"gal":       {USGallon, false},
+	"imp gal":   {ImperialGallon, false},
+	"gal (imp)": {ImperialGallon, false},
+}
+
+var pressureSymbols = map[string]struct {
+	unit     PressureUnit
+	prefixed bool
+}{
+	"Pa":    {Pascal, true},
+	"bar":   {Bar, true},
+	"atm":   {Atmosphere, false},
+	"torr":  {Torr, false},
+	"Torr":  {Torr, false},
+	"mmHg":  {MillimeterOfMercury, false},
+	"mm Hg": {MillimeterOfMercury, false},
+	"psi":   {PSI, false},
+}
+
+// ParseQuantity reads a measured quantity such as "25.0 mL", "3.2e-3 kg" or "1.5×10⁻³ g"
+// into a Mass, Volume or Pressure, along with how many significant figures were written.
+func ParseQuantity(text string) (Measurement, int, error) {
+	match := quantityPattern.FindStringSubmatch(superscripts.Replace(text))
+	if match == nil {
+		return nil, 0, fmt.Errorf("quantity %q does not start with a number", text)
+	}
+	mantissa, unit := match[1], match[4]
+	value, err := decimal.NewFromString(mantissa)
+	if err != nil {
+		return nil, 0, fmt.Errorf("quantity %q: %v", text, err)
+	}
+	if exponent := match[2] + match[3]; exponent != "" {
+		shift, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil {
+			return nil, 0, fmt.Errorf("quantity %q has an exponent out of range", text)
+		}
+		value = value.Shift(int32(shift))
+	}
+	sigFigs, err := GetSignificantFigures(strings.TrimLeft(mantissa, "+-"))
+	if err != nil {
+		return nil, 0, err
+	}
+	if unit == "" {
+		return nil, 0, fmt.Errorf("quantity %q has no unit", text)
+	}
+
+	property, err := parseUnit(value, unit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("quantity %q: %v", text, err)
+	}
+	return property, sigFigs, nil
+}
+
+// ParsePressure reads a pressure such as "101.3 kPa" or "745 mmHg", along with its significant figures.
+func ParsePressure(text string) (Pressure, int, error) {
+	measurement, sigFigs, err := ParseQuantity(text)
+	if err != nil {
+		return Pressure{}, 0, err
+	}
+	pressure, ok := measurement.(Pressure)
+	if !ok {
+		return Pressure{}, 0, fmt.Errorf("quantity %q is not a pressure", text)
+	}
+	return pressure, sigFigs, nil
+}
+
+// parseUnit builds a Mass, Volume or Pressure from a unit symbol with an optional prefix.
+func parseUnit(value decimal.Decimal, unit string) (Measurement, error) {
+	if m, found := massSymbols[unit]; found {
+		return NewMass(value, m.unit)
+	}
+	if v, found := volumeSymbols[unit]; found {
+		return NewVolume(value, v.unit)
+	}
+	if p, found := pressureSymbols[unit]; found {
+		return NewPressure(value, p.unit)
+	}
+	for _, p := range prefixSymbols {
+		base := strings.TrimPrefix(unit, p.symbol)
+		if base == unit {
+			continue
+		}
+		if m, found := massSymbols[base]; found && m.prefixed {
+			return NewMass(value, m.unit, p.prefix)
+		}
+		if v, found := volumeSymbols[base]; found && v.prefixed {
+			return NewVolume(value, v.unit, p.prefix)
+		}
+		if pressure, found := pressureSymbols[base]; found && pressure.prefixed {
+			return NewPressure(value, pressure.unit, p.prefix)
+		}
+	}
+	return nil, fmt.Errorf("unknown unit %q, expected a mass (g, Da, t, gr, oz, ozt, lb, st, ton, slug), volume (L, m3, cc, fl oz, cup, pt, qt, gal, imp gal) or pressure (Pa, bar, atm, torr, mmHg, psi) unit, with an optional SI prefix on g, Da, L, m3, Pa or bar", unit)
+}
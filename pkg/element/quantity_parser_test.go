@@ -0,0 +1,107 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		text             string
+		expectedStandard string
+		expectedVolume   bool
+		expectedSigFigs  int
+		expectedError    bool
+	}{
+		{text: "25.0 mL", expectedStandard: "0.025", expectedVolume: true, expectedSigFigs: 3},
+		{text: "3.2e-3 kg", expectedStandard: "3.2", expectedSigFigs: 2},
+		{text: "1.50×10^2 mg", expectedStandard: "0.15", expectedSigFigs: 3},
+		{text: "1.5 × 10⁻³ g", expectedStandard: "0.0015", expectedSigFigs: 2},
+		{text: "500 µg", expectedStandard: "0.0005", expectedSigFigs: 1},
+		{text: "500 μg", expectedStandard: "0.0005", expectedSigFigs: 1},
+		{text: "500. ug", expectedStandard: "0.0005", expectedSigFigs: 3},
+		{text: "250 ng", expectedStandard: "0.00000025", expectedSigFigs: 2},
+		{text: "1.2 Mg", expectedStandard: "1200000", expectedSigFigs: 2},
+		{text: "2 lb", expectedStandard: "907.18474", expectedSigFigs: 1},
+		{text: "3oz", expectedStandard: "85.048569375", expectedSigFigs: 1},
+		{text: "66.5 kDa", expectedStandard: "0.00000000000000000011042584792890", expectedSigFigs: 3},
+		{text: "2 ozt", expectedStandard: "62.2069536", expectedSigFigs: 1},
+		{text: "2.00 dm3", expectedStandard: "2", expectedVolume: true, expectedSigFigs: 3},
+		{text: "2.00 dm³", expectedStandard: "2", expectedVolume: true, expectedSigFigs: 3},
+		{text: "10 cm3", expectedStandard: "0.01", expectedVolume: true, expectedSigFigs: 1},
+		{text: "0.750 L", expectedStandard: "0.75", expectedVolume: true, expectedSigFigs: 3},
+		{text: "1 gal", expectedStandard: "3.785411784", expectedVolume: true, expectedSigFigs: 1},
+		{text: "2 imp gal", expectedStandard: "9.09218", expectedVolume: true, expectedSigFigs: 1},
+		{text: "2.0 gal (imp)", expectedStandard: "9.09218", expectedVolume: true, expectedSigFigs: 2},
+		{text: "12 fl oz", expectedStandard: "0.35488235475", expectedVolume: true, expectedSigFigs: 2},
+		{text: "5 furlongs", expectedError: true},
+		{text: "5 klb", expectedError: true},
+		{text: "5", expectedError: true},
+		{text: "mL", expectedError: true},
+		{text: "0 g", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			property, sigFigs, err := ParseQuantity(test.text)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if _, isVolume := property.(Volume); isVolume != test.expectedVolume {
+				t.Errorf("Expected volume: %v, but got %T", test.expectedVolume, property)
+			}
+			standard, err := convertToStandardValue(property)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !standard.Equal(decimal.RequireFromString(test.expectedStandard)) {
+				t.Errorf("Expected %s, but got %v", test.expectedStandard, standard)
+			}
+			if sigFigs != test.expectedSigFigs {
+				t.Errorf("Expected %d significant figures, but got %d", test.expectedSigFigs, sigFigs)
+			}
+		})
+	}
+}
+
+func TestParsePressure(t *testing.T) {
+	tests := []struct {
+		text            string
+		expectedAtm     string
+		expectedSigFigs int
+		expectedError   bool
+	}{
+		{text: "101.325 kPa", expectedAtm: "1", expectedSigFigs: 6},
+		{text: "745 mmHg", expectedAtm: "0.9802633", expectedSigFigs: 3},
+		{text: "380 torr", expectedAtm: "0.5", expectedSigFigs: 2},
+		{text: "1.00 atm", expectedAtm: "1", expectedSigFigs: 3},
+		{text: "1013.25 mbar", expectedAtm: "1", expectedSigFigs: 6},
+		{text: "14.7 psi", expectedAtm: "1.00027567", expectedSigFigs: 3},
+		{text: "2.5 g", expectedError: true},
+		{text: "3 katm", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.text, func(t *testing.T) {
+			p, sigFigs, err := ParsePressure(test.text)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			atmospheres, err := convertToStandardValue(p)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !atmospheres.Round(8).Equal(decimal.RequireFromString(test.expectedAtm)) {
+				t.Errorf("Expected %s atm, but got %v", test.expectedAtm, atmospheres)
+			}
+			if sigFigs != test.expectedSigFigs {
+				t.Errorf("Expected %d significant figures, but got %d", test.expectedSigFigs, sigFigs)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestQuantityConvertTo(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		from          string
+		to            string
+		expected      string
+		expectedSteps int
+		expectedError bool
+	}{
+		{name: "milliliters to liters", value: "25", from: "mL", to: "L", expected: "0.025", expectedSteps: 1},
+		{name: "pounds to kilograms", value: "2", from: "lb", to: "kg", expected: "0.90718474", expectedSteps: 1},
+		{name: "concentration", value: "5", from: "mg/mL", to: "g/L", expected: "5", expectedSteps: 2},
+		{name: "cubic meters to liters", value: "1", from: "m3", to: "L", expected: "1000", expectedSteps: 1},
+		{name: "cubic centimeters to cubic inches", value: "16.387064", from: "cm3", to: "in3", expected: "1", expectedSteps: 1},
+		{name: "atmospheres to torr", value: "1", from: "atm", to: "torr", expected: "760", expectedSteps: 1},
+		{name: "liter atmospheres to joules", value: "1", from: "L·atm", to: "J", expected: "101.325", expectedSteps: 1},
+		{name: "gas constant", value: "0.082057366080960", from: "L·atm/(mol·K)", to: "J/(mol·K)", expected: "8.314462618", expectedSteps: 1},
+		{name: "kilocalories to kilojoules", value: "1", from: "kcal", to: "kJ", expected: "4.184", expectedSteps: 1},
+		{name: "rankine to kelvin", value: "9", from: "R", to: "K", expected: "5", expectedSteps: 1},
+		{name: "hours to seconds", value: "1.5", from: "h", to: "s", expected: "5400", expectedSteps: 1},
+		{name: "same units", value: "3", from: "g", to: "g", expected: "3", expectedSteps: 0},
+		{name: "mass to volume", value: "1", from: "g", to: "mL", expectedError: true},
+		{name: "unknown target", value: "1", from: "g", to: "furlong", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			q, err := NewQuantity(decimal.RequireFromString(test.value), test.from)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			conversion, err := q.ConvertTo(test.to)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if !conversion.Result.Value().Round(9).Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s, but got %v", test.expected, conversion.Result.Value())
+			}
+			if len(conversion.Steps) != test.expectedSteps {
+				t.Errorf("Expected %d steps, but got %d: %s", test.expectedSteps, len(conversion.Steps), conversion.Text())
+			}
+		})
+	}
+}
+
+func TestConversionText(t *testing.T) {
+	tests := []struct {
+		value         string
+		from          string
+		to            string
+		expectedText  string
+		expectedLaTeX string
+	}{
+		{
+			value:         "25",
+			from:          "mL",
+			to:            "L",
+			expectedText:  "25 mL × (1 L / 1000 mL) = 0.025 L",
+			expectedLaTeX: `25\,\mathrm{mL} \times \frac{1\,\mathrm{L}}{1000\,\mathrm{mL}} = 0.025\,\mathrm{L}`,
+		},
+		{
+			value:         "0.5",
+			from:          "mol/L",
+			to:            "mol/mL",
+			expectedText:  "0.5 mol/L × (1 L / 1000 mL) = 0.0005 mol/mL",
+			expectedLaTeX: `0.5\,\frac{\mathrm{mol}}{\mathrm{L}} \times \frac{1\,\mathrm{L}}{1000\,\mathrm{mL}} = 0.0005\,\frac{\mathrm{mol}}{\mathrm{mL}}`,
+		},
+		{
+			value:         "2",
+			from:          "m3",
+			to:            "cm3",
+			expectedText:  "2 m³ × (100 cm / 1 m)³ = 2000000 cm³",
+			expectedLaTeX: `2\,\mathrm{m}^{3} \times \left(\frac{100\,\mathrm{cm}}{1\,\mathrm{m}}\right)^{3} = 2000000\,\mathrm{cm}^{3}`,
+		},
+		{
+			value:         "907.18474",
+			from:          "g",
+			to:            "lb",
+			expectedText:  "907.18474 g × (1 lb / 453.59237 g) = 2 lb",
+			expectedLaTeX: `907.18474\,\mathrm{g} \times \frac{1\,\mathrm{lb}}{453.59237\,\mathrm{g}} = 2\,\mathrm{lb}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.from+" to "+test.to, func(t *testing.T) {
+			q, err := NewQuantity(decimal.RequireFromString(test.value), test.from)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			conversion, err := q.ConvertTo(test.to)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if text := conversion.Text(); text != test.expectedText {
+				t.Errorf("Expected %s, but got %s", test.expectedText, text)
+			}
+			if latex := conversion.LaTeX(); latex != test.expectedLaTeX {
+				t.Errorf("Expected %s, but got %s", test.expectedLaTeX, latex)
+			}
+		})
+	}
+}
+
+func TestQuantityArithmetic(t *testing.T) {
+	mass, _ := NewQuantity(decimal.RequireFromString("58.44"), "g")
+	molarMass, _ := NewQuantity(decimal.RequireFromString("58.44"), "g/mol")
+	volume, _ := NewQuantity(decimal.RequireFromString("0.5"), "L")
+
+	moles, err := mass.Div(molarMass)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if moles.String() != "1 mol" {
+		t.Errorf("Expected 1 mol, but got %s", moles)
+	}
+	molarity, err := moles.Div(volume)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if molarity.String() != "2 mol/L" || molarity.Dimension() != (Dimension{Amount: 1, Length: -3}) {
+		t.Errorf("Expected 2 mol/L, but got %s", molarity)
+	}
+	if back := molarity.Mul(volume).Mul(molarMass); back.String() != "58.44 g" {
+		t.Errorf("Expected 58.44 g, but got %s", back)
+	}
+	if _, err := mass.Div(Quantity{}); err == nil {
+		t.Errorf("Expected an error dividing by zero")
+	}
+}
+
+func TestMassAndVolumeQuantities(t *testing.T) {
+	mass := Mass{value: decimal.NewFromInt(250), unit: Gram, prefix: Milli}
+	q, err := mass.Quantity()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if q.String() != "250 mg" {
+		t.Errorf("Expected 250 mg, but got %s", q)
+	}
+	volume := Volume{value: decimal.NewFromInt(2), unit: CubicMeter, prefix: Deci}
+	q, err = volume.Quantity()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if q.String() != "2 dm³" {
+		t.Errorf("Expected 2 dm³, but got %s", q)
+	}
+}
@@ -0,0 +1,210 @@
+package element
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// RealGas holds the van der Waals constants and critical point of a gas.
+type RealGas struct {
+	A                   float64 // van der Waals a in L²·bar/mol²
+	B                   float64 // van der Waals b in L/mol
+	CriticalTemperature float64 // K
+	CriticalPressure    float64 // bar
+}
+
+// realGases is keyed by Compound.Symbol. Values are from the CRC Handbook of Chemistry and Physics.
+var realGases = map[string]RealGas{
+	"He":   {A: 0.0346, B: 0.0238, CriticalTemperature: 5.19, CriticalPressure: 2.27},
+	"Ne":   {A: 0.208, B: 0.01672, CriticalTemperature: 44.4, CriticalPressure: 27.6},
+	"Ar":   {A: 1.355, B: 0.0320, CriticalTemperature: 150.87, CriticalPressure: 48.98},
+	"Kr":   {A: 2.325, B: 0.0396, CriticalTemperature: 209.48, CriticalPressure: 55.25},
+	"Xe":   {A: 4.192, B: 0.0516, CriticalTemperature: 289.73, CriticalPressure: 58.42},
+	"H2":   {A: 0.2476, B: 0.02661, CriticalTemperature: 33.145, CriticalPressure: 12.964},
+	"N2":   {A: 1.370, B: 0.0387, CriticalTemperature: 126.19, CriticalPressure: 33.96},
+	"O2":   {A: 1.382, B: 0.03186, CriticalTemperature: 154.58, CriticalPressure: 50.43},
+	"Cl2":  {A: 6.343, B: 0.0542, CriticalTemperature: 416.9, CriticalPressure: 79.91},
+	"CO":   {A: 1.472, B: 0.03948, CriticalTemperature: 132.86, CriticalPressure: 34.94},
+	"CO2":  {A: 3.640, B: 0.04267, CriticalTemperature: 304.13, CriticalPressure: 73.77},
+	"NO":   {A: 1.46, B: 0.0289, CriticalTemperature: 180, CriticalPressure: 64.8},
+	"N2O":  {A: 3.852, B: 0.0444, CriticalTemperature: 309.57, CriticalPressure: 72.45},
+	"NH3":  {A: 4.225, B: 0.0371, CriticalTemperature: 405.56, CriticalPressure: 113.57},
+	"H2O":  {A: 5.537, B: 0.03049, CriticalTemperature: 647.10, CriticalPressure: 220.64},
+	"H2S":  {A: 4.544, B: 0.0434, CriticalTemperature: 373.1, CriticalPressure: 90.0},
+	"HCl":  {A: 3.700, B: 0.0406, CriticalTemperature: 324.7, CriticalPressure: 83.1},
+	"SO2":  {A: 7.137, B: 0.0568, CriticalTemperature: 430.64, CriticalPressure: 78.84},
+	"CH4":  {A: 2.303, B: 0.0431, CriticalTemperature: 190.56, CriticalPressure: 45.99},
+	"C2H6": {A: 5.562, B: 0.0638, CriticalTemperature: 305.32, CriticalPressure: 48.72},
+	"C3H8": {A: 9.39, B: 0.0905, CriticalTemperature: 369.83, CriticalPressure: 42.48},
+}
+
+// FindRealGas looks up the constants for a compound by its symbol.
+func FindRealGas(compound Compound) (RealGas, error) {
+	gas, found := realGases[compound.Symbol]
+	if !found {
+		return RealGas{}, fmt.Errorf("no real gas constants for %s", compound.Symbol)
+	}
+	return gas, nil
+}
+
+// EquationOfState picks the real gas model to solve with.
+type EquationOfState int
+
+const (
+	VanDerWaals EquationOfState = iota + 1
+	RedlichKwong
+)
+
+// RealGasState is a solved state of a real gas and how far it strays from ideal.
+type RealGasState struct {
+	Pressure        Pressure        // in bar
+	Volume          Volume          // in liters
+	MolarVolume     decimal.Decimal // L/mol
+	Compressibility decimal.Decimal // Z = PVm/RT, 1 for an ideal gas
+}
+
+// stateEquation gives the pressure of the gas at a molar volume and the cubic in molar volume
+// whose roots are the states at a pressure, as coefficients of Vm³, Vm², Vm and 1.
+type stateEquation struct {
+	pressure func(molarVolume float64) float64
+	cubic    func(pressure float64) [4]float64
+	b        float64
+}
+
+func (eos EquationOfState) equation(gas RealGas, r, k float64) (stateEquation, error) {
+	switch eos {
+	case VanDerWaals:
+		a, b := gas.A, gas.B
+		return stateEquation{
+			pressure: func(vm float64) float64 { return r*k/(vm-b) - a/(vm*vm) },
+			cubic: func(p float64) [4]float64 {
+				return [4]float64{p, -(p*b + r*k), a, -a * b}
+			},
+			b: b,
+		}, nil
+	case RedlichKwong:
+		// a = Ωa R² Tc^2.5 / Pc and b = Ωb R Tc / Pc, where Ωb = (2^(1/3) - 1)/3 and Ωa = 1/(9(2^(1/3) - 1)).
+		omegaB := (math.Cbrt(2) - 1) / 3
+		omegaA := 1 / (9 * (math.Cbrt(2) - 1))
+		a := omegaA * r * r * math.Pow(gas.CriticalTemperature, 2.5) / gas.CriticalPressure
+		b := omegaB * r * gas.CriticalTemperature / gas.CriticalPressure
+		sqrtT := math.Sqrt(k)
+		return stateEquation{
+			pressure: func(vm float64) float64 { return r*k/(vm-b) - a/(sqrtT*vm*(vm+b)) },
+			cubic: func(p float64) [4]float64 {
+				return [4]float64{p, -r * k, a/sqrtT - p*b*b - r*k*b, -a * b / sqrtT}
+			},
+			b: b,
+		}, nil
+	}
+	return stateEquation{}, fmt.Errorf("unknown equation of state")
+}
+
+// realGasSetup gathers the constants, R in L·bar/(mol·K) and the temperature in kelvin.
+func realGasSetup(eos EquationOfState, compound Compound, moles decimal.Decimal, t Temperature) (stateEquation, float64, float64, error) {
+	gas, err := FindRealGas(compound)
+	if err != nil {
+		return stateEquation{}, 0, 0, err
+	}
+	if !moles.IsPositive() {
+		return stateEquation{}, 0, 0, fmt.Errorf("moles of gas must be positive, got %v", moles)
+	}
+	r, err := GasConstantIn(Bar, NoPrefix, Liter, NoPrefix)
+	if err != nil {
+		return stateEquation{}, 0, 0, err
+	}
+	k, err := kelvin(t)
+	if err != nil {
+		return stateEquation{}, 0, 0, err
+	}
+	m, err := eos.equation(gas, r.InexactFloat64(), k.InexactFloat64())
+	return m, r.InexactFloat64(), k.InexactFloat64(), err
+}
+
+// RealGasPressure finds the pressure of moles of a gas held in a volume at a temperature.
+func RealGasPressure(eos EquationOfState, compound Compound, v Volume, moles decimal.Decimal, t Temperature) (RealGasState, error) {
+	m, r, k, err := realGasSetup(eos, compound, moles, t)
+	if err != nil {
+		return RealGasState{}, err
+	}
+	liters, err := v.convertToStandard()
+	if err != nil {
+		return RealGasState{}, err
+	}
+	vm := liters.InexactFloat64() / moles.InexactFloat64()
+	if vm <= m.b {
+		return RealGasState{}, fmt.Errorf("%v L/mol is smaller than the %v L/mol the molecules themselves take up", vm, m.b)
+	}
+	return realGasState(m.pressure(vm), vm, moles, r, k)
+}
+
+// RealGasVolume finds the volume of moles of a gas at a pressure and temperature by solving the
+// equation of state's cubic for molar volume. When the cubic has three roots, the largest is the gas.
+func RealGasVolume(eos EquationOfState, compound Compound, p Pressure, moles decimal.Decimal, t Temperature) (RealGasState, error) {
+	m, r, k, err := realGasSetup(eos, compound, moles, t)
+	if err != nil {
+		return RealGasState{}, err
+	}
+	bar, err := p.Convert(Bar, NoPrefix)
+	if err != nil {
+		return RealGasState{}, err
+	}
+	pressure := bar.value.InexactFloat64()
+	roots := cubicRoots(m.cubic(pressure))
+	if len(roots) == 0 || roots[len(roots)-1] <= m.b {
+		return RealGasState{}, fmt.Errorf("no gas volume for %s at %v bar", compound.Symbol, pressure)
+	}
+	return realGasState(pressure, roots[len(roots)-1], moles, r, k)
+}
+
+func realGasState(pressure, molarVolume float64, moles decimal.Decimal, r, k float64) (RealGasState, error) {
+	if pressure <= 0 {
+		return RealGasState{}, fmt.Errorf("the equation of state gives no positive pressure at %v L/mol", molarVolume)
+	}
+	vm := decimal.NewFromFloat(molarVolume)
+	return RealGasState{
+		Pressure:        Pressure{value: decimal.NewFromFloat(pressure), unit: Bar, prefix: NoPrefix},
+		Volume:          Volume{value: vm.Mul(moles), unit: Liter, prefix: NoPrefix},
+		MolarVolume:     vm,
+		Compressibility: decimal.NewFromFloat(pressure * molarVolume / (r * k)),
+	}, nil
+}
+
+// cubicRoots returns the real roots of c[0]x³ + c[1]x² + c[2]x + c[3] in ascending order.
+func cubicRoots(c [4]float64) []float64 {
+	a, b, d := c[1]/c[0], c[2]/c[0], c[3]/c[0]
+	// Substitute x = t - a/3 for the depressed cubic t³ + pt + q.
+	p := b - a*a/3
+	q := 2*a*a*a/27 - a*b/3 + d
+	shift := -a / 3
+	var roots []float64
+	if discriminant := q*q/4 + p*p*p/27; p == 0 {
+		roots = []float64{math.Cbrt(-q) + shift}
+	} else if discriminant > 0 {
+		s := math.Sqrt(discriminant)
+		roots = []float64{math.Cbrt(-q/2+s) + math.Cbrt(-q/2-s) + shift}
+	} else {
+		radius := 2 * math.Sqrt(-p/3)
+		angle := math.Acos(math.Max(-1, math.Min(1, 3*q/(p*radius))))
+		for i := 0; i < 3; i++ {
+			roots = append(roots, radius*math.Cos((angle-2*math.Pi*float64(i))/3)+shift)
+		}
+	}
+	// A few Newton steps clean up the rounding in the closed forms.
+	for i, x := range roots {
+		for step := 0; step < 4; step++ {
+			f := ((c[0]*x+c[1])*x+c[2])*x + c[3]
+			df := (3*c[0]*x+2*c[1])*x + c[2]
+			if df == 0 {
+				break
+			}
+			x -= f / df
+		}
+		roots[i] = x
+	}
+	sort.Float64s(roots)
+	return roots
+}
@@ -0,0 +1,118 @@
+package element
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRealGasPressure(t *testing.T) {
+	pt := NewPeriodicTable()
+	co2, _ := NewCompound("CO2", pt)
+	xx := Compound{Symbol: "XX"}
+	warm, _ := NewTemperature(decimal.NewFromInt(300), Kelvin)
+	liter, _ := NewVolume(decimal.NewFromInt(1))
+	tiny, _ := NewVolume(decimal.RequireFromString("0.04"))
+	roomy, _ := NewVolume(decimal.NewFromInt(1000))
+	one := decimal.NewFromInt(1)
+	tests := []struct {
+		name          string
+		eos           EquationOfState
+		compound      Compound
+		volume        Volume
+		moles         decimal.Decimal
+		expectedBar   float64
+		expectedZ     float64
+		expectedError bool
+	}{
+		{name: "van der Waals CO2", eos: VanDerWaals, compound: co2, volume: liter, moles: one, expectedBar: 22.4149, expectedZ: 0.8986},
+		{name: "Redlich-Kwong CO2", eos: RedlichKwong, compound: co2, volume: liter, moles: one, expectedBar: 22.0837, expectedZ: 0.8854},
+		{name: "nearly ideal at low pressure", eos: VanDerWaals, compound: co2, volume: roomy, moles: one, expectedBar: 0.024941, expectedZ: 0.9999},
+		{name: "volume below b", eos: VanDerWaals, compound: co2, volume: tiny, moles: one, expectedError: true},
+		{name: "no moles", eos: VanDerWaals, compound: co2, volume: liter, moles: decimal.Zero, expectedError: true},
+		{name: "no constants", eos: VanDerWaals, compound: xx, volume: liter, moles: one, expectedError: true},
+		{name: "unknown equation", compound: co2, volume: liter, moles: one, expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, err := RealGasPressure(test.eos, test.compound, test.volume, test.moles, warm)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if bar := state.Pressure.Value().InexactFloat64(); math.Abs(bar-test.expectedBar) > 1e-4*test.expectedBar {
+				t.Errorf("Expected %v bar, but got %v", test.expectedBar, bar)
+			}
+			if z := state.Compressibility.InexactFloat64(); math.Abs(z-test.expectedZ) > 1e-4 {
+				t.Errorf("Expected Z = %v, but got %v", test.expectedZ, z)
+			}
+		})
+	}
+}
+
+func TestRealGasVolume(t *testing.T) {
+	pt := NewPeriodicTable()
+	warm, _ := NewTemperature(decimal.NewFromInt(300), Kelvin)
+	boiling, _ := NewTemperature(decimal.NewFromInt(373), Kelvin)
+	two := decimal.NewFromInt(2)
+	tests := []struct {
+		name     string
+		eos      EquationOfState
+		formula  string
+		pressure string
+		t        Temperature
+	}{
+		{name: "van der Waals CO2", eos: VanDerWaals, formula: "CO2", pressure: "22.4149", t: warm},
+		{name: "Redlich-Kwong NH3", eos: RedlichKwong, formula: "NH3", pressure: "8", t: warm},
+		{name: "van der Waals steam takes the gas root", eos: VanDerWaals, formula: "H2O", pressure: "1", t: boiling},
+		{name: "Redlich-Kwong steam takes the gas root", eos: RedlichKwong, formula: "H2O", pressure: "1", t: boiling},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compound, _ := NewCompound(test.formula, pt)
+			p, _ := NewPressure(decimal.RequireFromString(test.pressure), Bar)
+			state, err := RealGasVolume(test.eos, compound, p, two, test.t)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			back, err := RealGasPressure(test.eos, compound, state.Volume, two, test.t)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := back.Pressure.Value().Sub(p.Value()).Abs(); diff.GreaterThan(decimal.RequireFromString("0.000001")) {
+				t.Errorf("Expected %v bar back from %v L, but got %v", p.Value(), state.Volume.Value(), back.Pressure.Value())
+			}
+			if z := state.Compressibility.InexactFloat64(); z < 0.8 || z > 1 {
+				t.Errorf("Expected a gas with Z just under 1, but got %v", z)
+			}
+		})
+	}
+}
+
+func TestCubicRoots(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        [4]float64
+		expected []float64
+	}{
+		{name: "three roots", c: [4]float64{1, -6, 11, -6}, expected: []float64{1, 2, 3}},
+		{name: "one root", c: [4]float64{1, 0, 1, -2}, expected: []float64{1}},
+		{name: "triple root", c: [4]float64{2, -6, 6, -2}, expected: []float64{1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			roots := cubicRoots(test.c)
+			if len(roots) != len(test.expected) {
+				t.Fatalf("Expected %v, but got %v", test.expected, roots)
+			}
+			for i, root := range roots {
+				if math.Abs(root-test.expected[i]) > 1e-6 {
+					t.Errorf("Expected %v, but got %v", test.expected, roots)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ConcentrationUnit is a way of measuring how much solute a solution holds.
+type ConcentrationUnit int
+
+const (
+	Molarity        ConcentrationUnit = iota + 1 // mol solute per L of solution
+	Molality                                     // mol solute per kg of solvent
+	MoleFraction                                 // mol solute per mol of solute and solvent
+	MassPercent                                  // g solute per 100 g of solution
+	PartsPerMillion                              // g solute per 10⁶ g of solution
+	PartsPerBillion                              // g solute per 10⁹ g of solution
+	Normality                                    // equivalents of solute per L of solution
+)
+
+func (u ConcentrationUnit) String() string {
+	switch u {
+	case Molarity:
+		return "M"
+	case Molality:
+		return "m"
+	case MoleFraction:
+		return "χ"
+	case MassPercent:
+		return "% w/w"
+	case PartsPerMillion:
+		return "ppm"
+	case PartsPerBillion:
+		return "ppb"
+	case Normality:
+		return "N"
+	}
+	return "unknown concentration unit"
+}
+
+// Solution is a solute dissolved in a solvent, with the amount of each in its Moles.
+// The volume-based measures, molarity and normality, need either the Volume of the
+// solution or its Density in g/mL; Equivalents is the number of equivalents per mole
+// of solute, such as 2 for H2SO4 as an acid, and is only needed for normality.
+type Solution struct {
+	Solute      Compound
+	Solvent     Compound
+	Volume      Volume
+	Density     decimal.Decimal
+	Equivalents decimal.Decimal
+}
+
+// NewSolution checks the amounts of solute and solvent and fills in their molar masses.
+// A zero density means it is unknown.
+func NewSolution(solute, solvent Compound, density decimal.Decimal) (Solution, error) {
+	s := Solution{Solute: solute, Solvent: solvent, Density: density}
+	if err := s.check(); err != nil {
+		return Solution{}, err
+	}
+	if !solute.Moles.IsPositive() || !solvent.Moles.IsPositive() {
+		return Solution{}, fmt.Errorf("moles of solute and solvent must be positive, got %v and %v", solute.Moles, solvent.Moles)
+	}
+	return s, nil
+}
+
+func (s *Solution) check() error {
+	for _, compound := range []*Compound{&s.Solute, &s.Solvent} {
+		if compound.MolarMass.Equal(decimal.Zero) {
+			if err := compound.getMolarMass(); err != nil {
+				return fmt.Errorf("%s: %v", compound.Symbol, err)
+			}
+		}
+	}
+	if s.Density.IsNegative() {
+		return fmt.Errorf("density must be positive, got %v", s.Density)
+	}
+	return nil
+}
+
+func (s Solution) soluteMass() decimal.Decimal {
+	return s.Solute.Moles.Mul(s.Solute.MolarMass)
+}
+
+func (s Solution) solventMass() decimal.Decimal {
+	return s.Solvent.Moles.Mul(s.Solvent.MolarMass)
+}
+
+// liters is the volume of the solution, measured or worked out from the density.
+func (s Solution) liters() (decimal.Decimal, error) {
+	if !s.Volume.value.IsZero() {
+		return s.Volume.convertToStandard()
+	}
+	if s.Density.IsPositive() {
+		milliliters := quotient(s.soluteMass().Add(s.solventMass()), s.Density)
+		return milliliters.Div(decimal.NewFromInt(1000)), nil
+	}
+	return decimal.Zero, fmt.Errorf("a volume or density is needed to go between mass and volume based concentrations")
+}
+
+func (s Solution) equivalents() (decimal.Decimal, error) {
+	if !s.Equivalents.IsPositive() {
+		return decimal.Zero, fmt.Errorf("normality needs the equivalents per mole of %s", s.Solute.Symbol)
+	}
+	return s.Equivalents, nil
+}
+
+// Concentration is the solution's concentration in the given unit.
+func (s Solution) Concentration(unit ConcentrationUnit) (decimal.Decimal, error) {
+	if err := s.check(); err != nil {
+		return decimal.Zero, err
+	}
+	total := s.soluteMass().Add(s.solventMass())
+	if !total.IsPositive() {
+		return decimal.Zero, fmt.Errorf("the solution is empty")
+	}
+	switch unit {
+	case Molarity, Normality:
+		liters, err := s.liters()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		molarity := quotient(s.Solute.Moles, liters)
+		if unit == Molarity {
+			return molarity, nil
+		}
+		equivalents, err := s.equivalents()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return molarity.Mul(equivalents), nil
+	case Molality:
+		if !s.Solvent.Moles.IsPositive() {
+			return decimal.Zero, fmt.Errorf("molality needs some solvent")
+		}
+		return quotient(s.Solute.Moles.Mul(decimal.NewFromInt(1000)), s.solventMass()), nil
+	case MoleFraction:
+		return quotient(s.Solute.Moles, s.Solute.Moles.Add(s.Solvent.Moles)), nil
+	case MassPercent:
+		return quotient(s.soluteMass().Mul(decimal.NewFromInt(100)), total), nil
+	case PartsPerMillion:
+		return quotient(s.soluteMass().Mul(decimal.NewFromInt(1000000)), total), nil
+	case PartsPerBillion:
+		return quotient(s.soluteMass().Mul(decimal.NewFromInt(1000000000)), total), nil
+	}
+	return decimal.Zero, fmt.Errorf("unknown concentration unit %d", unit)
+}
+
+// SetConcentration sets the amounts of solute and solvent to match a concentration, so any
+// other unit can then be read with Concentration. Concentration does not depend on how much
+// solution there is, so the amounts are for a convenient basis, such as 1 kg of solvent for
+// molality or 1 L of solution for molarity, and any measured Volume is cleared.
+func (s *Solution) SetConcentration(value decimal.Decimal, unit ConcentrationUnit) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+	if !value.IsPositive() {
+		return fmt.Errorf("concentration must be positive, got %v", value)
+	}
+	var soluteMoles, solventGrams decimal.Decimal
+	switch unit {
+	case Molarity, Normality:
+		molarity := value
+		if unit == Normality {
+			equivalents, err := s.equivalents()
+			if err != nil {
+				return err
+			}
+			molarity = quotient(value, equivalents)
+		}
+		if !s.Density.IsPositive() {
+			return fmt.Errorf("a density is needed to find the solvent in a %v %v solution", value, unit)
+		}
+		// 1 L of solution
+		soluteMoles = molarity
+		solventGrams = s.Density.Mul(decimal.NewFromInt(1000)).Sub(molarity.Mul(s.Solute.MolarMass))
+	case Molality:
+		// 1 kg of solvent
+		soluteMoles, solventGrams = value, decimal.NewFromInt(1000)
+	case MoleFraction:
+		// 1 mol of solute and solvent
+		if value.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+			return fmt.Errorf("a mole fraction must be below 1, got %v", value)
+		}
+		soluteMoles = value
+		solventGrams = decimal.NewFromInt(1).Sub(value).Mul(s.Solvent.MolarMass)
+	case MassPercent, PartsPerMillion, PartsPerBillion:
+		// 100 g, 10⁶ g or 10⁹ g of solution
+		total := map[ConcentrationUnit]int64{MassPercent: 100, PartsPerMillion: 1000000, PartsPerBillion: 1000000000}[unit]
+		soluteMoles = quotient(value, s.Solute.MolarMass)
+		solventGrams = decimal.NewFromInt(total).Sub(value)
+	default:
+		return fmt.Errorf("unknown concentration unit %d", unit)
+	}
+	if !solventGrams.IsPositive() {
+		return fmt.Errorf("a %v %v solution leaves no room for solvent", value, unit)
+	}
+	s.Solute.Moles = soluteMoles
+	s.Solvent.Moles = quotient(solventGrams, s.Solvent.MolarMass)
+	s.Volume = Volume{}
+	return nil
+}
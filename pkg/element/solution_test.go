@@ -0,0 +1,127 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSolutionConcentration(t *testing.T) {
+	pt := NewPeriodicTable()
+	hcl, _ := NewCompound("HCl", pt)
+	water, _ := NewCompound("H2O", pt)
+	concentrated := Solution{Solute: hcl, Solvent: water, Density: decimal.RequireFromString("1.18"), Equivalents: decimal.NewFromInt(1)}
+	if err := concentrated.SetConcentration(decimal.NewFromInt(12), Molarity); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	tests := []struct {
+		unit     ConcentrationUnit
+		expected string
+	}{
+		{unit: Molarity, expected: "12"},
+		{unit: Normality, expected: "12"},
+		{unit: Molality, expected: "16.1615"},
+		{unit: MoleFraction, expected: "0.2255"},
+		{unit: MassPercent, expected: "37.0759"},
+		{unit: PartsPerMillion, expected: "370759.322"},
+		{unit: PartsPerBillion, expected: "370759322.0339"},
+	}
+	for _, test := range tests {
+		t.Run(test.unit.String(), func(t *testing.T) {
+			actual, err := concentrated.Concentration(test.unit)
+			if err != nil || !actual.Round(4).Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s %v, but got %v (%v)", test.expected, test.unit, actual, err)
+			}
+		})
+	}
+}
+
+func TestSolutionRoundTrip(t *testing.T) {
+	pt := NewPeriodicTable()
+	sulfuric, _ := NewCompound("H2SO4", pt)
+	water, _ := NewCompound("H2O", pt)
+	tests := []struct {
+		name  string
+		value string
+		unit  ConcentrationUnit
+	}{
+		{name: "molarity", value: "0.5", unit: Molarity},
+		{name: "normality", value: "2", unit: Normality},
+		{name: "molality", value: "1.25", unit: Molality},
+		{name: "mole fraction", value: "0.05", unit: MoleFraction},
+		{name: "mass percent", value: "9.8", unit: MassPercent},
+		{name: "ppm", value: "250", unit: PartsPerMillion},
+		{name: "ppb", value: "12", unit: PartsPerBillion},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := Solution{Solute: sulfuric, Solvent: water, Density: decimal.RequireFromString("1.05"), Equivalents: decimal.NewFromInt(2)}
+			value := decimal.RequireFromString(test.value)
+			if err := s.SetConcentration(value, test.unit); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			actual, err := s.Concentration(test.unit)
+			if err != nil || !actual.Round(10).Equal(value) {
+				t.Errorf("Expected %v %v back, but got %v (%v)", value, test.unit, actual, err)
+			}
+		})
+	}
+}
+
+func TestSolutionFromAmounts(t *testing.T) {
+	pt := NewPeriodicTable()
+	salt, _ := NewCompound("NaCl", pt)
+	water, _ := NewCompound("H2O", pt)
+	salt.Moles = decimal.RequireFromString("0.25")
+	water.Moles = decimal.RequireFromString("27.75")
+	s, err := NewSolution(salt, water, decimal.Zero)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fraction, _ := s.Concentration(MoleFraction); !fraction.Round(16).Equal(decimal.RequireFromString("0.0089285714285714")) {
+		t.Errorf("Expected a mole fraction of 0.25/28, but got %v", fraction)
+	}
+	if _, err := s.Concentration(Molarity); err == nil {
+		t.Errorf("Expected an error for molarity without a volume or density")
+	}
+	s.Volume, _ = NewVolume(decimal.NewFromInt(500), Milli)
+	if molarity, err := s.Concentration(Molarity); err != nil || !molarity.Equal(decimal.RequireFromString("0.5")) {
+		t.Errorf("Expected 0.5 M, but got %v (%v)", molarity, err)
+	}
+	if _, err := s.Concentration(Normality); err == nil {
+		t.Errorf("Expected an error for normality without equivalents")
+	}
+
+	salt.Moles = decimal.Zero
+	if _, err := NewSolution(salt, water, decimal.Zero); err == nil {
+		t.Errorf("Expected an error for a solution without solute")
+	}
+}
+
+func TestSetConcentrationErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	salt, _ := NewCompound("NaCl", pt)
+	water, _ := NewCompound("H2O", pt)
+	tests := []struct {
+		name    string
+		density string
+		value   string
+		unit    ConcentrationUnit
+	}{
+		{name: "molarity without density", density: "0", value: "1", unit: Molarity},
+		{name: "normality without equivalents", density: "1.1", value: "1", unit: Normality},
+		{name: "more solute than the solution weighs", density: "1.0", value: "20", unit: Molarity},
+		{name: "mole fraction of one", density: "0", value: "1", unit: MoleFraction},
+		{name: "all solute", density: "0", value: "100", unit: MassPercent},
+		{name: "zero concentration", density: "0", value: "0", unit: Molality},
+		{name: "unknown unit", density: "0", value: "1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := Solution{Solute: salt, Solvent: water, Density: decimal.RequireFromString(test.density)}
+			if err := s.SetConcentration(decimal.RequireFromString(test.value), test.unit); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package element
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// electronMolarMass is the molar mass of the electron in g/mol (CODATA 2018).
+var electronMolarMass = decimal.RequireFromString("0.000548579909065")
+
+var chargeSuffix = regexp.MustCompile(`(\^?)(\d*)([+-])$`)
+var singleElement = regexp.MustCompile(`^[A-Z][a-z]?$`)
+
+// electronSymbols are the ways an electron is written in half-reactions.
+var electronSymbols = map[string]bool{"e-": true, "e^-": true, "e⁻": true}
+
+var superscripts = strings.NewReplacer(
+	"⁰", "0", "¹", "1", "²", "2", "³", "3", "⁴", "4",
+	"⁵", "5", "⁶", "6", "⁷", "7", "⁸", "8", "⁹", "9",
+	"⁺", "+", "⁻", "-",
+)
+
+// Species is a compound that may carry an ionic charge, such as SO4^2-, Fe3+ or [Cu(NH3)4]2+.
+type Species struct {
+	Compound
+	Charge int
+}
+
+// NewSpecies parses a formula with an optional charge suffix.
+// Charges may be written "^2-", "2-", "-", or with superscripts "²⁻".
+// Without a caret a single digit before the sign is the charge only when it follows
+// a lone element or bracket group ("Fe3+", "[Fe(CN)6]4-"); otherwise it is a subscript
+// ("NH4+"). With two or more digits the last digit is the charge ("SO42-").
+// Use the caret form whenever this would be ambiguous.
+// An electron, "e-", is a species with no elements and a charge of -1.
+func NewSpecies(symbol string, pt *PeriodicTable) (Species, error) {
+	if electronSymbols[symbol] {
+		return Species{Compound: Compound{Symbol: symbol}, Charge: -1}, nil
+	}
+	body, charge, err := splitCharge(symbol)
+	if err != nil {
+		return Species{}, err
+	}
+	compound, err := NewCompound(body, pt)
+	if err != nil {
+		var formulaErr *FormulaError
+		if errors.As(err, &formulaErr) {
+			formulaErr.Formula = symbol
+		}
+		return Species{}, err
+	}
+	compound.Symbol = symbol
+	return Species{Compound: compound, Charge: charge}, nil
+}
+
+// IonMolarMass returns the molar mass, optionally corrected for the electrons removed or added.
+func (s Species) IonMolarMass(correctForElectrons bool) decimal.Decimal {
+	if !correctForElectrons {
+		return s.MolarMass
+	}
+	return s.MolarMass.Sub(electronMolarMass.Mul(decimal.NewFromInt(int64(s.Charge))))
+}
+
+// splitCharge separates the neutral formula from its charge suffix.
+func splitCharge(symbol string) (string, int, error) {
+	normalized := symbol
+	suffixStart := -1
+	if trimmed := strings.TrimRight(symbol, "⁰¹²³⁴⁵⁶⁷⁸⁹⁺⁻"); trimmed != symbol {
+		normalized = trimmed + "^" + superscripts.Replace(symbol[len(trimmed):])
+		suffixStart = len(trimmed)
+	}
+	match := chargeSuffix.FindStringSubmatchIndex(normalized)
+	if match == nil {
+		return symbol, 0, nil
+	}
+	if suffixStart < 0 {
+		suffixStart = match[0]
+	}
+	body := normalized[:match[0]]
+	caret := match[3] > match[2]
+	digits := normalized[match[4]:match[5]]
+	sign := 1
+	if normalized[match[6]] == '-' {
+		sign = -1
+	}
+
+	magnitude := "1"
+	switch {
+	case caret && digits != "":
+		magnitude = digits
+	case len(digits) >= 2:
+		body += digits[:len(digits)-1]
+		magnitude = digits[len(digits)-1:]
+	case len(digits) == 1 && isSingleUnit(body):
+		magnitude = digits
+	default:
+		body += digits
+	}
+	charge, err := strconv.Atoi(magnitude)
+	if err != nil || charge == 0 {
+		return "", 0, &FormulaError{Formula: symbol, Offset: suffixStart, Token: symbol[suffixStart:], Expected: "nonzero charge"}
+	}
+	return body, sign * charge, nil
+}
+
+// isSingleUnit reports whether body is one element symbol or one bracketed group.
+func isSingleUnit(body string) bool {
+	if singleElement.MatchString(body) {
+		return true
+	}
+	if body == "" || closers[body[0]] == 0 {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(body); i++ {
+		switch {
+		case closers[body[i]] != 0:
+			depth++
+		case isCloser(body[i]):
+			depth--
+			if depth == 0 {
+				return i == len(body)-1
+			}
+		}
+	}
+	return false
+}
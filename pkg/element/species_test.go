@@ -0,0 +1,78 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewSpecies(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		symbol            string
+		expectedCharge    int
+		expectedMolarMass string
+		expectedError     bool
+	}{
+		{symbol: "SO4^2-", expectedCharge: -2, expectedMolarMass: "96.061"},
+		{symbol: "SO42-", expectedCharge: -2, expectedMolarMass: "96.061"},
+		{symbol: "SO4²⁻", expectedCharge: -2, expectedMolarMass: "96.061"},
+		{symbol: "Fe3+", expectedCharge: 3, expectedMolarMass: "55.845"},
+		{symbol: "NH4+", expectedCharge: 1, expectedMolarMass: "18.039"},
+		{symbol: "OH-", expectedCharge: -1, expectedMolarMass: "17.007"},
+		{symbol: "O2-", expectedCharge: -2, expectedMolarMass: "15.999"},
+		{symbol: "O2^-", expectedCharge: -1, expectedMolarMass: "31.998"},
+		{symbol: "Hg22+", expectedCharge: 2, expectedMolarMass: "401.184"},
+		{symbol: "[Cu(NH3)4]2+", expectedCharge: 2, expectedMolarMass: "131.67"},
+		{symbol: "[Fe(CN)6]4-", expectedCharge: -4, expectedMolarMass: "211.953"},
+		{symbol: "H2O", expectedCharge: 0, expectedMolarMass: "18.015"},
+		{symbol: "Na0+", expectedError: true},
+		{symbol: "+", expectedError: true},
+		{symbol: "SO4^2", expectedError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.symbol, func(t *testing.T) {
+			species, err := NewSpecies(test.symbol, pt)
+			if (err != nil) != test.expectedError {
+				t.Fatalf("Expected error: %v, but got: %v", test.expectedError, err)
+			}
+			if test.expectedError {
+				return
+			}
+			if species.Charge != test.expectedCharge {
+				t.Errorf("Expected charge %d, but got %d", test.expectedCharge, species.Charge)
+			}
+			if !species.MolarMass.Equal(decimal.RequireFromString(test.expectedMolarMass)) {
+				t.Errorf("Expected molar mass %s, but got %v", test.expectedMolarMass, species.MolarMass)
+			}
+		})
+	}
+}
+
+func TestIonMolarMass(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		symbol   string
+		expected string
+	}{
+		{symbol: "Na+", expected: "22.989220700090935"},
+		{symbol: "Cl-", expected: "35.450548579909065"},
+		{symbol: "Ar", expected: "39.948"},
+		{symbol: "e-", expected: "0.000548579909065"},
+	}
+	for _, test := range tests {
+		t.Run(test.symbol, func(t *testing.T) {
+			species, err := NewSpecies(test.symbol, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !species.IonMolarMass(false).Equal(species.MolarMass) {
+				t.Errorf("Expected uncorrected molar mass %v, but got %v", species.MolarMass, species.IonMolarMass(false))
+			}
+			actual := species.IonMolarMass(true)
+			if !actual.Equal(decimal.RequireFromString(test.expected)) {
+				t.Errorf("Expected %s, but got %v", test.expected, actual)
+			}
+		})
+	}
+}
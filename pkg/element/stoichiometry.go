@@ -0,0 +1,132 @@
+package element
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Amount is how much of a reactant is on hand: a Mass, a Volume of solution at a molarity, or moles.
+type Amount struct {
+	mass     *Mass
+	volume   *Volume
+	molarity decimal.Decimal
+	moles    decimal.Decimal
+}
+
+// AmountOfMass measures a reactant by mass.
+func AmountOfMass(m Mass) Amount {
+	return Amount{mass: &m}
+}
+
+// AmountOfSolution measures a reactant as a volume of solution at the given molarity (mol/L).
+func AmountOfSolution(v Volume, molarity decimal.Decimal) Amount {
+	return Amount{volume: &v, molarity: molarity}
+}
+
+// AmountOfMoles measures a reactant directly in moles.
+func AmountOfMoles(moles decimal.Decimal) Amount {
+	return Amount{moles: moles}
+}
+
+func (a Amount) getMoles(compound Compound) (decimal.Decimal, error) {
+	switch {
+	case a.mass != nil:
+		return a.mass.getMoles(compound.MolarMass)
+	case a.volume != nil:
+		return a.volume.getMoles(a.molarity)
+	case a.moles.IsPositive():
+		return a.moles, nil
+	default:
+		return decimal.Zero, fmt.Errorf("amount of %s must be positive", compound.Symbol)
+	}
+}
+
+// ReagentAmount is a quantity of one species in moles and in grams.
+type ReagentAmount struct {
+	Symbol string
+	Moles  decimal.Decimal
+	Mass   Mass
+}
+
+// YieldReport is the outcome of running a balanced reaction to completion.
+type YieldReport struct {
+	LimitingReagent  string
+	Extent           decimal.Decimal // moles of reaction, as written, that take place
+	Excess           []ReagentAmount // what remains of every other reactant that was measured
+	TheoreticalYield []ReagentAmount // what forms of each product
+}
+
+// LimitingReagent finds which reactant runs out first and how much of everything is left or made.
+// Amounts are keyed by species symbol; reactants without an amount are taken to be in excess.
+func (r Reaction) LimitingReagent(amounts map[string]Amount) (YieldReport, error) {
+	if !r.IsBalanced() {
+		return YieldReport{}, fmt.Errorf("reaction %s must be balanced first", r)
+	}
+	available := make(map[string]decimal.Decimal)
+	report := YieldReport{}
+	for _, term := range r.Reactants {
+		amount, given := amounts[term.Species.Symbol]
+		if !given {
+			continue
+		}
+		moles, err := amount.getMoles(term.Species.Compound)
+		if err != nil {
+			return YieldReport{}, err
+		}
+		available[term.Species.Symbol] = moles
+		extent := moles.Div(decimal.NewFromInt(term.Coefficient))
+		if report.LimitingReagent == "" || extent.LessThan(report.Extent) {
+			report.LimitingReagent = term.Species.Symbol
+			report.Extent = extent
+		}
+	}
+	for symbol := range amounts {
+		if _, found := available[symbol]; !found {
+			return YieldReport{}, fmt.Errorf("%s is not a reactant in %s", symbol, r)
+		}
+	}
+	if report.LimitingReagent == "" {
+		return YieldReport{}, fmt.Errorf("no reactant amounts passed")
+	}
+
+	for _, term := range r.Reactants {
+		moles, given := available[term.Species.Symbol]
+		if !given || term.Species.Symbol == report.LimitingReagent {
+			continue
+		}
+		remaining := moles.Sub(report.Extent.Mul(decimal.NewFromInt(term.Coefficient)))
+		report.Excess = append(report.Excess, newReagentAmount(term.Species, remaining))
+	}
+	for _, term := range r.Products {
+		formed := report.Extent.Mul(decimal.NewFromInt(term.Coefficient))
+		report.TheoreticalYield = append(report.TheoreticalYield, newReagentAmount(term.Species, formed))
+	}
+	return report, nil
+}
+
+// PercentYield compares the actual mass of a product to its theoretical yield.
+func (y YieldReport) PercentYield(product string, actual Mass) (decimal.Decimal, error) {
+	for _, theoretical := range y.TheoreticalYield {
+		if theoretical.Symbol != product {
+			continue
+		}
+		if theoretical.Mass.value.IsZero() {
+			return decimal.Zero, fmt.Errorf("theoretical yield of %s has no mass", product)
+		}
+		actualMass, err := actual.convertToStandard()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return actualMass.Div(theoretical.Mass.value).Mul(decimal.NewFromInt(100)), nil
+	}
+	return decimal.Zero, fmt.Errorf("%s is not a product of the reaction", product)
+}
+
+func newReagentAmount(species Species, moles decimal.Decimal) ReagentAmount {
+	return ReagentAmount{
+		Symbol: species.Symbol,
+		Moles:  moles,
+		Mass:   Mass{value: moles.Mul(species.MolarMass), unit: Gram, prefix: NoPrefix},
+	}
+}
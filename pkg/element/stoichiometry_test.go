@@ -0,0 +1,122 @@
+package element
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLimitingReagent(t *testing.T) {
+	pt := NewPeriodicTable()
+	tests := []struct {
+		name             string
+		equation         string
+		amounts          map[string]Amount
+		expectedLimiting string
+		expectedExcess   map[string]string // symbol to grams remaining
+		expectedYield    map[string]string // symbol to grams formed
+	}{
+		{
+			name:     "Haber process by mass",
+			equation: "N2 + 3H2 -> 2NH3",
+			amounts: map[string]Amount{
+				"N2": AmountOfMass(Mass{value: decimal.RequireFromString("56.028"), unit: Gram, prefix: NoPrefix}),
+				"H2": AmountOfMass(Mass{value: decimal.RequireFromString("6.048"), unit: Gram, prefix: NoPrefix}),
+			},
+			expectedLimiting: "H2",
+			expectedExcess:   map[string]string{"N2": "28.014"},
+			expectedYield:    map[string]string{"NH3": "34.062"},
+		},
+		{
+			name:     "Neutralization by volume and moles",
+			equation: "HCl + NaOH -> NaCl + H2O",
+			amounts: map[string]Amount{
+				"HCl":  AmountOfSolution(Volume{value: decimal.NewFromInt(25), unit: Liter, prefix: Milli}, decimal.RequireFromString("0.1")),
+				"NaOH": AmountOfMoles(decimal.RequireFromString("0.002")),
+			},
+			expectedLimiting: "NaOH",
+			expectedExcess:   map[string]string{"HCl": "0.018229"},
+			expectedYield:    map[string]string{"NaCl": "0.11687953856", "H2O": "0.03603"},
+		},
+		{
+			name:     "Oxygen in excess",
+			equation: "CH4 + 2O2 -> CO2 + 2H2O",
+			amounts: map[string]Amount{
+				"CH4": AmountOfMoles(decimal.NewFromInt(2)),
+			},
+			expectedLimiting: "CH4",
+			expectedExcess:   map[string]string{},
+			expectedYield:    map[string]string{"CO2": "88.018", "H2O": "72.06"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reaction, err := ParseEquation(test.equation, pt)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			report, err := reaction.LimitingReagent(test.amounts)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if report.LimitingReagent != test.expectedLimiting {
+				t.Errorf("Expected %s to be limiting, but got %s", test.expectedLimiting, report.LimitingReagent)
+			}
+			if len(report.Excess) != len(test.expectedExcess) {
+				t.Errorf("Expected %d excess reactants, but got %d", len(test.expectedExcess), len(report.Excess))
+			}
+			for _, excess := range report.Excess {
+				if !excess.Mass.Value().Equal(decimal.RequireFromString(test.expectedExcess[excess.Symbol])) {
+					t.Errorf("Expected %s g of %s left, but got %v", test.expectedExcess[excess.Symbol], excess.Symbol, excess.Mass.Value())
+				}
+			}
+			for _, product := range report.TheoreticalYield {
+				if !product.Mass.Value().Equal(decimal.RequireFromString(test.expectedYield[product.Symbol])) {
+					t.Errorf("Expected %s g of %s, but got %v", test.expectedYield[product.Symbol], product.Symbol, product.Mass.Value())
+				}
+			}
+		})
+	}
+}
+
+func TestPercentYield(t *testing.T) {
+	reaction, _ := ParseEquation("N2 + 3H2 -> 2NH3", NewPeriodicTable())
+	report, err := reaction.LimitingReagent(map[string]Amount{"H2": AmountOfMoles(decimal.NewFromInt(3))})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	percent, err := report.PercentYield("NH3", Mass{value: decimal.RequireFromString("17.031"), unit: Gram, prefix: NoPrefix})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !percent.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("Expected 50 percent yield, but got %v", percent)
+	}
+	if _, err := report.PercentYield("N2", Mass{value: decimal.NewFromInt(1), unit: Gram, prefix: NoPrefix}); err == nil {
+		t.Errorf("Expected an error for a reactant")
+	}
+}
+
+func TestLimitingReagentErrors(t *testing.T) {
+	pt := NewPeriodicTable()
+	unbalanced, _ := ParseEquation("N2 + H2 -> NH3", pt)
+	balanced, _ := ParseEquation("N2 + 3H2 -> 2NH3", pt)
+	tests := []struct {
+		name     string
+		reaction Reaction
+		amounts  map[string]Amount
+	}{
+		{name: "unbalanced", reaction: unbalanced, amounts: map[string]Amount{"N2": AmountOfMoles(decimal.NewFromInt(1))}},
+		{name: "no amounts", reaction: balanced, amounts: map[string]Amount{}},
+		{name: "product given", reaction: balanced, amounts: map[string]Amount{"NH3": AmountOfMoles(decimal.NewFromInt(1))}},
+		{name: "zero moles", reaction: balanced, amounts: map[string]Amount{"N2": AmountOfMoles(decimal.Zero)}},
+		{name: "zero molarity", reaction: balanced, amounts: map[string]Amount{"N2": AmountOfSolution(Volume{value: decimal.NewFromInt(1), unit: Liter, prefix: NoPrefix}, decimal.Zero)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.reaction.LimitingReagent(test.amounts); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}